@@ -3,10 +3,12 @@ package main
 import (
 	"github.com/hashicorp/terraform/builtin/providers/atlas"
 	"github.com/hashicorp/terraform/plugin"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: atlas.Provider,
+		Version:      terraform.Version,
 	})
 }
@@ -3,10 +3,12 @@ package main
 import (
 	"github.com/hashicorp/terraform/builtin/providers/terraform"
 	"github.com/hashicorp/terraform/plugin"
+	core "github.com/hashicorp/terraform/terraform"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: terraform.Provider,
+		Version:      core.Version,
 	})
 }
@@ -11,5 +11,6 @@ func main() {
 		ProvisionerFunc: func() terraform.ResourceProvisioner {
 			return new(chef.ResourceProvisioner)
 		},
+		Version: terraform.Version,
 	})
 }
@@ -11,5 +11,6 @@ func main() {
 		ProvisionerFunc: func() terraform.ResourceProvisioner {
 			return new(file.ResourceProvisioner)
 		},
+		Version: terraform.Version,
 	})
 }
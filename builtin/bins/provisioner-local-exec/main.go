@@ -11,5 +11,6 @@ func main() {
 		ProvisionerFunc: func() terraform.ResourceProvisioner {
 			return new(localexec.ResourceProvisioner)
 		},
+		Version: terraform.Version,
 	})
 }
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"github.com/hashicorp/terraform/builtin/provisioners/template-file"
+	"github.com/hashicorp/terraform/plugin"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func main() {
+	plugin.Serve(&plugin.ServeOpts{
+		ProvisionerFunc: func() terraform.ResourceProvisioner {
+			return new(templatefile.ResourceProvisioner)
+		},
+		Version: terraform.Version,
+	})
+}
@@ -46,6 +46,10 @@ type Config struct {
 	Region     string
 	MaxRetries int
 
+	// MaxRequestsPerSecond limits the rate of requests sent to AWS.
+	// Zero means no limit.
+	MaxRequestsPerSecond int
+
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
@@ -101,6 +105,13 @@ func (c *Config) Client() (interface{}, error) {
 		// bucket storage in S3
 		client.region = c.Region
 
+		// All the sessions share a single limiter, so that the rate
+		// limit applies to the provider as a whole.
+		var limiter *requestLimiter
+		if c.MaxRequestsPerSecond > 0 {
+			limiter = newRequestLimiter(c.MaxRequestsPerSecond)
+		}
+
 		log.Println("[INFO] Building AWS auth structure")
 		// We fetched all credential sources in Provider. If they are
 		// available, they'll already be in c. See Provider definition.
@@ -114,6 +125,7 @@ func (c *Config) Client() (interface{}, error) {
 
 		log.Println("[INFO] Initializing IAM Connection")
 		sess := session.New(awsConfig)
+		limitRequests(sess, limiter)
 		client.iamconn = iam.New(sess)
 
 		err := c.ValidateCredentials(client.iamconn)
@@ -133,12 +145,14 @@ func (c *Config) Client() (interface{}, error) {
 			HTTPClient:  cleanhttp.DefaultClient(),
 		}
 		usEast1Sess := session.New(usEast1AwsConfig)
+		limitRequests(usEast1Sess, limiter)
 
 		awsDynamoDBConfig := *awsConfig
 		awsDynamoDBConfig.Endpoint = aws.String(c.DynamoDBEndpoint)
 
 		log.Println("[INFO] Initializing DynamoDB connection")
 		dynamoSess := session.New(&awsDynamoDBConfig)
+		limitRequests(dynamoSess, limiter)
 		client.dynamodbconn = dynamodb.New(dynamoSess)
 
 		log.Println("[INFO] Initializing ELB connection")
@@ -161,6 +175,7 @@ func (c *Config) Client() (interface{}, error) {
 
 		log.Println("[INFO] Initializing Kinesis Connection")
 		kinesisSess := session.New(&awsKinesisConfig)
+		limitRequests(kinesisSess, limiter)
 		client.kinesisconn = kinesis.New(kinesisSess)
 
 		authErr := c.ValidateAccountId(client.iamconn)
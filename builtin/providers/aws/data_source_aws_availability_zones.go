@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsAvailabilityZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsAvailabilityZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsAvailabilityZonesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Reading availability zones")
+	req := &ec2.DescribeAvailabilityZonesInput{
+		Filters: []*ec2.Filter{
+			&ec2.Filter{
+				Name:   aws.String("state"),
+				Values: []*string{aws.String("available")},
+			},
+		},
+	}
+
+	resp, err := conn.DescribeAvailabilityZones(req)
+	if err != nil {
+		return fmt.Errorf("Error fetching availability zones: %s", err)
+	}
+
+	names := make([]string, 0, len(resp.AvailabilityZones))
+	for _, az := range resp.AvailabilityZones {
+		names = append(names, *az.ZoneName)
+	}
+	sort.Strings(names)
+
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting availability zone names: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAvailabilityZones_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckAwsAvailabilityZonesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAvailabilityZonesMeta("data.aws_availability_zones.availability_zones"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAvailabilityZonesMeta(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find AZ resource: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("AZ resource ID not set")
+		}
+
+		count := rs.Primary.Attributes["names.#"]
+		if count == "" || count == "0" {
+			return fmt.Errorf("No availability zones found: %#v", rs.Primary.Attributes)
+		}
+
+		return nil
+	}
+}
+
+const testAccCheckAwsAvailabilityZonesConfig = `
+data "aws_availability_zones" "availability_zones" {
+}
+`
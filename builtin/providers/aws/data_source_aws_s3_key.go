@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsS3Key() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsS3KeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"key": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"default": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				Set: dataSourceAwsS3KeyHash,
+			},
+
+			"var": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsS3KeyHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["path"].(string)))
+	if v, ok := m["default"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func dataSourceAwsS3KeyRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+
+	// Store the computed vars
+	vars := make(map[string]string)
+
+	keys := d.Get("key").(*schema.Set).List()
+	for _, raw := range keys {
+		sub, ok := raw.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("Failed to unroll: %#v", raw)
+		}
+
+		name := sub["name"].(string)
+		path := sub["path"].(string)
+
+		log.Printf("[DEBUG] Reading S3 key '%s' from bucket %s", path, bucket)
+		value, found, err := dataSourceAwsS3KeyGet(s3conn, bucket, path)
+		if err != nil {
+			return err
+		}
+		if !found {
+			log.Printf("[DEBUG] S3 key '%s' not found in bucket %s, using default", path, bucket)
+			value, _ = sub["default"].(string)
+		}
+
+		vars[name] = value
+	}
+
+	d.SetId(bucket)
+	d.Set("var", vars)
+	return nil
+}
+
+// dataSourceAwsS3KeyGet fetches the body of a single object. The returned
+// bool is false if the object does not exist.
+func dataSourceAwsS3KeyGet(s3conn *s3.S3, bucket, path string) (string, bool, error) {
+	resp, err := s3conn.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("Error reading S3 key '%s' from bucket %s: %s", path, bucket, err)
+	}
+	defer resp.Body.Close()
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return "", false, fmt.Errorf("Error reading S3 key '%s' from bucket %s: %s", path, bucket, err)
+	}
+
+	return buf.String(), true, nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3KeyDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3KeyDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3KeyValue("data.aws_s3_key.app", "present", "some_bucket_content"),
+					testAccCheckAWSS3KeyValue("data.aws_s3_key.app", "missing", "fallback"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3KeyValue(n, attr, val string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+		out, ok := rs.Primary.Attributes["var."+attr]
+		if !ok {
+			return fmt.Errorf("Attribute '%s' not found: %#v", attr, rs.Primary.Attributes)
+		}
+		if out != val {
+			return fmt.Errorf("Attribute '%s' value '%s' != '%s'", attr, out, val)
+		}
+		return nil
+	}
+}
+
+var testAccAWSS3KeyDataSourceConfig = fmt.Sprintf(`
+resource "aws_s3_bucket" "key_bucket" {
+	bucket = "tf-key-data-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.key_bucket.bucket}"
+	key = "test-key"
+	content = "some_bucket_content"
+}
+
+data "aws_s3_key" "app" {
+	bucket = "${aws_s3_bucket_object.object.bucket}"
+
+	key {
+		name = "present"
+		path = "${aws_s3_bucket_object.object.key}"
+	}
+
+	key {
+		name = "missing"
+		path = "does-not-exist"
+		default = "fallback"
+	}
+}
+`, randInt)
@@ -127,6 +127,13 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_requests_per_second": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: descriptions["max_requests_per_second"],
+			},
+
 			"allowed_account_ids": &schema.Schema{
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -162,6 +169,11 @@ func Provider() terraform.ResourceProvider {
 			},
 		},
 
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_availability_zones": dataSourceAwsAvailabilityZones(),
+			"aws_s3_key":             dataSourceAwsS3Key(),
+		},
+
 		ResourcesMap: map[string]*schema.Resource{
 			"aws_ami":                          resourceAwsAmi(),
 			"aws_ami_copy":                     resourceAwsAmiCopy(),
@@ -290,6 +302,10 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"max_requests_per_second": "The maximum number of requests per second sent\n" +
+			"to AWS by the provider. This helps to avoid being throttled by\n" +
+			"AWS when many resources are managed at once. Defaults to no limit.",
+
 		"dynamodb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to dynamodb-local.",
 
@@ -307,6 +323,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		MaxRetries:       d.Get("max_retries").(int),
 		DynamoDBEndpoint: d.Get("dynamodb_endpoint").(string),
 		KinesisEndpoint:  d.Get("kinesis_endpoint").(string),
+
+		MaxRequestsPerSecond: d.Get("max_requests_per_second").(int),
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsIamRole() *schema.Resource {
 		// TODO
 		//Update: resourceAwsIamRoleUpdate,
 		Delete: resourceAwsIamRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
@@ -71,7 +75,12 @@ func resourceAwsIamRoleCreate(d *schema.ResourceData, meta interface{}) error {
 		AssumeRolePolicyDocument: aws.String(d.Get("assume_role_policy").(string)),
 	}
 
-	createResp, err := iamconn.CreateRole(request)
+	var createResp *iam.CreateRoleOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		createResp, err = iamconn.CreateRole(request)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating IAM Role %s: %s", name, err)
 	}
@@ -85,7 +94,12 @@ func resourceAwsIamRoleRead(d *schema.ResourceData, meta interface{}) error {
 		RoleName: aws.String(d.Id()),
 	}
 
-	getResp, err := iamconn.GetRole(request)
+	var getResp *iam.GetRoleOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		getResp, err = iamconn.GetRole(request)
+		return err
+	})
 	if err != nil {
 		if iamerr, ok := err.(awserr.Error); ok && iamerr.Code() == "NoSuchEntity" { // XXX test me
 			d.SetId("")
@@ -110,6 +124,19 @@ func resourceAwsIamRoleReadResult(d *schema.ResourceData, role *iam.Role) error
 	if err := d.Set("unique_id", role.RoleId); err != nil {
 		return err
 	}
+
+	// The policy is only read back when it isn't already known, such as
+	// when the role is being imported, so that formatting differences
+	// don't show up as changes.
+	if _, ok := d.GetOk("assume_role_policy"); !ok && role.AssumeRolePolicyDocument != nil {
+		policy, err := url.QueryUnescape(*role.AssumeRolePolicyDocument)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("assume_role_policy", policy); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -141,7 +168,11 @@ func resourceAwsIamRoleDelete(d *schema.ResourceData, meta interface{}) error {
 		RoleName: aws.String(d.Id()),
 	}
 
-	if _, err := iamconn.DeleteRole(request); err != nil {
+	err = retryOnAwsThrottle(func() error {
+		_, err := iamconn.DeleteRole(request)
+		return err
+	})
+	if err != nil {
 		return fmt.Errorf("Error deleting IAM Role %s: %s", d.Id(), err)
 	}
 	return nil
@@ -46,6 +46,7 @@ func resourceAwsIAMServerCertificate() *schema.Resource {
 				Required:  true,
 				ForceNew:  true,
 				StateFunc: normalizeCert,
+				Sensitive: true,
 			},
 
 			"name": &schema.Schema{
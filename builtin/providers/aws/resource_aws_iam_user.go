@@ -60,7 +60,12 @@ func resourceAwsIamUserCreate(d *schema.ResourceData, meta interface{}) error {
 		UserName: aws.String(name),
 	}
 
-	createResp, err := iamconn.CreateUser(request)
+	var createResp *iam.CreateUserOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		createResp, err = iamconn.CreateUser(request)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating IAM User %s: %s", name, err)
 	}
@@ -74,7 +79,12 @@ func resourceAwsIamUserRead(d *schema.ResourceData, meta interface{}) error {
 		UserName: aws.String(d.Id()),
 	}
 
-	getResp, err := iamconn.GetUser(request)
+	var getResp *iam.GetUserOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		getResp, err = iamconn.GetUser(request)
+		return err
+	})
 	if err != nil {
 		if iamerr, ok := err.(awserr.Error); ok && iamerr.Code() == "NoSuchEntity" { // XXX test me
 			d.SetId("")
@@ -109,7 +119,11 @@ func resourceAwsIamUserDelete(d *schema.ResourceData, meta interface{}) error {
 		UserName: aws.String(d.Id()),
 	}
 
-	if _, err := iamconn.DeleteUser(request); err != nil {
+	err := retryOnAwsThrottle(func() error {
+		_, err := iamconn.DeleteUser(request)
+		return err
+	})
+	if err != nil {
 		return fmt.Errorf("Error deleting IAM User %s: %s", d.Id(), err)
 	}
 	return nil
@@ -353,20 +353,32 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	// Create the instance
 	log.Printf("[DEBUG] Run configuration: %s", runOpts)
 
-	var runResp *ec2.Reservation
-	for i := 0; i < 5; i++ {
-		runResp, err = conn.RunInstances(runOpts)
-		if awsErr, ok := err.(awserr.Error); ok {
-			// IAM profiles can take ~10 seconds to propagate in AWS:
-			//  http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html#launch-instance-with-role-console
-			if awsErr.Code() == "InvalidParameterValue" && strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile") {
-				log.Printf("[DEBUG] Invalid IAM Instance Profile referenced, retrying...")
-				time.Sleep(2 * time.Second)
-				continue
+	// Retry while AWS throttles us, and while the IAM instance profile
+	// hasn't propagated yet. IAM profiles can take ~10 seconds to
+	// propagate in AWS:
+	//  http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/iam-roles-for-amazon-ec2.html#launch-instance-with-role-console
+	runRetry := &resource.BackoffConf{
+		MaxRetries: 5,
+		MinDelay:   2 * time.Second,
+		MaxDelay:   15 * time.Second,
+		Retryable: func(err error) bool {
+			if awsErr, ok := err.(awserr.Error); ok {
+				if awsErr.Code() == "InvalidParameterValue" && strings.Contains(awsErr.Message(), "Invalid IAM Instance Profile") {
+					log.Printf("[DEBUG] Invalid IAM Instance Profile referenced, retrying...")
+					return true
+				}
 			}
-		}
-		break
+
+			return isAWSThrottleErr(err)
+		},
 	}
+
+	var runResp *ec2.Reservation
+	err = runRetry.Retry(func() error {
+		var err error
+		runResp, err = conn.RunInstances(runOpts)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Error launching source instance: %s", err)
 	}
@@ -421,8 +433,13 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	resp, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
-		InstanceIds: []*string{aws.String(d.Id())},
+	var resp *ec2.DescribeInstancesOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		resp, err = conn.DescribeInstances(&ec2.DescribeInstancesInput{
+			InstanceIds: []*string{aws.String(d.Id())},
+		})
+		return err
 	})
 	if err != nil {
 		// If the instance was not found, return nil so that we can show
@@ -99,8 +99,9 @@ func resourceAwsOpsworksStack() *schema.Resource {
 						},
 
 						"password": &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
 						},
 
 						"revision": &schema.Schema{
@@ -96,8 +96,9 @@ func resourceAwsRDSCluster() *schema.Resource {
 			},
 
 			"master_password": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
 			},
 
 			"port": &schema.Schema{
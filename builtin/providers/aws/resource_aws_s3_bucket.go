@@ -20,6 +20,9 @@ func resourceAwsS3Bucket() *schema.Resource {
 		Read:   resourceAwsS3BucketRead,
 		Update: resourceAwsS3BucketUpdate,
 		Delete: resourceAwsS3BucketDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": &schema.Schema{
@@ -184,7 +187,10 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	_, err := s3conn.CreateBucket(req)
+	err := retryOnAwsThrottle(func() error {
+		_, err := s3conn.CreateBucket(req)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating S3 bucket: %s", err)
 	}
@@ -231,9 +237,11 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
-	var err error
-	_, err = s3conn.HeadBucket(&s3.HeadBucketInput{
-		Bucket: aws.String(d.Id()),
+	err := retryOnAwsThrottle(func() error {
+		_, err := s3conn.HeadBucket(&s3.HeadBucketInput{
+			Bucket: aws.String(d.Id()),
+		})
+		return err
 	})
 	if err != nil {
 		if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() == 404 {
@@ -247,6 +255,11 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Importing only gives us the ID, which is the bucket name
+	if _, ok := d.GetOk("bucket"); !ok {
+		d.Set("bucket", d.Id())
+	}
+
 	// Read the policy
 	pol, err := s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
 		Bucket: aws.String(d.Id()),
@@ -391,8 +404,11 @@ func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
 	log.Printf("[DEBUG] S3 Delete Bucket: %s", d.Id())
-	_, err := s3conn.DeleteBucket(&s3.DeleteBucketInput{
-		Bucket: aws.String(d.Id()),
+	err := retryOnAwsThrottle(func() error {
+		_, err := s3conn.DeleteBucket(&s3.DeleteBucketInput{
+			Bucket: aws.String(d.Id()),
+		})
+		return err
 	})
 	if err != nil {
 		ec2err, ok := err.(awserr.Error)
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -18,6 +21,7 @@ func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3BucketObjectPut,
 		Read:   resourceAwsS3BucketObjectRead,
+		Update: resourceAwsS3BucketObjectUpdate,
 		Delete: resourceAwsS3BucketObjectDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +31,13 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"acl": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: validateS3BucketObjectAclType,
+			},
+
 			"cache_control": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -76,11 +87,28 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"source"},
+				Sensitive:     true,
+			},
+
+			"storage_class": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateS3BucketObjectStorageClassType,
 			},
 
+			// The etag is the MD5 of the object for objects that aren't
+			// encrypted with a customer key or uploaded in parts. The diff
+			// only sees the path of the source, not its contents, so the
+			// etag must be set to the MD5 of the source (see the md5
+			// interpolation function) for a change to the file to upload
+			// it again.
 			"etag": &schema.Schema{
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
 			},
 		},
 	}
@@ -92,6 +120,7 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 	var body io.ReadSeeker
+	var contentType string
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -99,8 +128,10 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
 		}
+		defer file.Close()
 
 		body = file
+		contentType = mime.TypeByExtension(filepath.Ext(source))
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
@@ -111,15 +142,25 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 	putInput := &s3.PutObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
+		ACL:    aws.String(d.Get("acl").(string)),
 		Body:   body,
 	}
 
+	if v, ok := d.GetOk("storage_class"); ok {
+		putInput.StorageClass = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("cache_control"); ok {
 		putInput.CacheControl = aws.String(v.(string))
 	}
 
+	// Without an explicit content type we guess it from the extension
+	// of the source file, falling back to the S3 default.
 	if v, ok := d.GetOk("content_type"); ok {
-		putInput.ContentType = aws.String(v.(string))
+		contentType = v.(string)
+	}
+	if contentType != "" {
+		putInput.ContentType = aws.String(contentType)
 	}
 
 	if v, ok := d.GetOk("content_encoding"); ok {
@@ -134,29 +175,56 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ContentDisposition = aws.String(v.(string))
 	}
 
-	resp, err := s3conn.PutObject(putInput)
+	err := retryOnAwsThrottle(func() error {
+		_, err := s3conn.PutObject(putInput)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
-	d.Set("etag", resp.ETag)
 	d.SetId(key)
-	return nil
+	return resourceAwsS3BucketObjectRead(d, meta)
 }
 
-func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
-	etag := d.Get("etag").(string)
 
-	resp, err := s3conn.HeadObject(
-		&s3.HeadObjectInput{
-			Bucket:  aws.String(bucket),
-			Key:     aws.String(key),
-			IfMatch: aws.String(etag),
+	// Everything else forces a new upload, so the ACL is all that can
+	// change here.
+	if d.HasChange("acl") {
+		_, err := s3conn.PutObjectAcl(&s3.PutObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			ACL:    aws.String(d.Get("acl").(string)),
 		})
+		if err != nil {
+			return fmt.Errorf("Error putting S3 object ACL: %s", err)
+		}
+	}
+
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+	s3conn := meta.(*AWSClient).s3conn
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	var resp *s3.HeadObjectOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		resp, err = s3conn.HeadObject(
+			&s3.HeadObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			})
+		return err
+	})
 
 	if err != nil {
 		// If S3 returns a 404 Request Failure, mark the object as destroyed
@@ -173,6 +241,21 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
 	d.Set("content_type", resp.ContentType)
+	// S3 returns the etag quoted
+	if resp.ETag != nil {
+		d.Set("etag", strings.Trim(*resp.ETag, `"`))
+	}
+
+	// The acl isn't read back: S3 only returns the grants, and several
+	// canned ACLs result in the same grants, so changes made outside of
+	// Terraform aren't detected.
+
+	// The storage class is only returned for non-standard objects
+	storageClass := s3.StorageClassStandard
+	if resp.StorageClass != nil {
+		storageClass = *resp.StorageClass
+	}
+	d.Set("storage_class", storageClass)
 
 	log.Printf("[DEBUG] Reading S3 Bucket Object meta: %s", resp)
 	return nil
@@ -194,3 +277,45 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 	}
 	return nil
 }
+
+func validateS3BucketObjectAclType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	cannedAcls := map[string]bool{
+		s3.ObjectCannedACLPrivate:                true,
+		s3.ObjectCannedACLPublicRead:             true,
+		s3.ObjectCannedACLPublicReadWrite:        true,
+		s3.ObjectCannedACLAuthenticatedRead:      true,
+		s3.ObjectCannedACLAwsExecRead:            true,
+		s3.ObjectCannedACLBucketOwnerRead:        true,
+		s3.ObjectCannedACLBucketOwnerFullControl: true,
+	}
+
+	if !cannedAcls[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid canned ACL type %q. Valid types are either %q, %q, %q, %q, %q, %q, or %q",
+			k, value, s3.ObjectCannedACLPrivate, s3.ObjectCannedACLPublicRead,
+			s3.ObjectCannedACLPublicReadWrite, s3.ObjectCannedACLAuthenticatedRead,
+			s3.ObjectCannedACLAwsExecRead, s3.ObjectCannedACLBucketOwnerRead,
+			s3.ObjectCannedACLBucketOwnerFullControl))
+	}
+	return
+}
+
+func validateS3BucketObjectStorageClassType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	storageClasses := map[string]bool{
+		s3.StorageClassStandard:          true,
+		s3.StorageClassReducedRedundancy: true,
+		s3.StorageClassStandardIa:        true,
+	}
+
+	if !storageClasses[value] {
+		errors = append(errors, fmt.Errorf(
+			"%q contains an invalid Storage Class type %q. Valid types are either %q, %q, or %q",
+			k, value, s3.StorageClassStandard, s3.StorageClassReducedRedundancy,
+			s3.StorageClassStandardIa))
+	}
+	return
+}
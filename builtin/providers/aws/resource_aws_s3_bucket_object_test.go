@@ -80,6 +80,72 @@ func TestAccAWSS3BucketObject_withContentCharacteristics(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_aclAndStorageClass(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_aclAndStorageClass("private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object", "acl", "private"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object", "storage_class", "REDUCED_REDUNDANCY"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object", "etag", "3aa092e6f0fe468e376603aaeb32b5b8"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_aclAndStorageClass("public-read"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket_object.object", "acl", "public-read"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateS3BucketObjectAclType(t *testing.T) {
+	validTypes := []string{"private", "public-read", "bucket-owner-full-control"}
+	for _, v := range validTypes {
+		_, errors := validateS3BucketObjectAclType(v, "acl")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid canned ACL type: %v", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"public-write", "PRIVATE", ""}
+	for _, v := range invalidTypes {
+		_, errors := validateS3BucketObjectAclType(v, "acl")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid canned ACL type", v)
+		}
+	}
+}
+
+func TestValidateS3BucketObjectStorageClassType(t *testing.T) {
+	validTypes := []string{"STANDARD", "REDUCED_REDUNDANCY", "STANDARD_IA"}
+	for _, v := range validTypes {
+		_, errors := validateS3BucketObjectStorageClassType(v, "storage_class")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid storage class: %v", v, errors)
+		}
+	}
+
+	invalidTypes := []string{"GLACIER", "standard", ""}
+	for _, v := range invalidTypes {
+		_, errors := validateS3BucketObjectStorageClassType(v, "storage_class")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid storage class", v)
+		}
+	}
+}
+
 func testAccCheckAWSS3BucketObjectDestroy(s *terraform.State) error {
 	s3conn := testAccProvider.Meta().(*AWSClient).s3conn
 
@@ -166,3 +232,19 @@ resource "aws_s3_bucket_object" "object" {
         content = "some_bucket_content"
 }
 `, randomBucket)
+
+func testAccAWSS3BucketObjectConfig_aclAndStorageClass(acl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket.bucket}"
+	key = "test-key"
+	content = "some_bucket_content"
+	acl = "%s"
+	storage_class = "REDUCED_REDUNDANCY"
+}
+`, randomBucket, acl)
+}
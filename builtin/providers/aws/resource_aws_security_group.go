@@ -183,10 +183,14 @@ func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	securityGroupOpts.GroupName = aws.String(groupName)
 
-	var err error
 	log.Printf(
 		"[DEBUG] Security Group create configuration: %#v", securityGroupOpts)
-	createResp, err := conn.CreateSecurityGroup(securityGroupOpts)
+	var createResp *ec2.CreateSecurityGroupOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		createResp, err = conn.CreateSecurityGroup(securityGroupOpts)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating Security Group: %s", err)
 	}
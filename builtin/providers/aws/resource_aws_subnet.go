@@ -18,6 +18,9 @@ func resourceAwsSubnet() *schema.Resource {
 		Read:   resourceAwsSubnetRead,
 		Update: resourceAwsSubnetUpdate,
 		Delete: resourceAwsSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
@@ -60,8 +63,12 @@ func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		VpcId:            aws.String(d.Get("vpc_id").(string)),
 	}
 
-	var err error
-	resp, err := conn.CreateSubnet(createOpts)
+	var resp *ec2.CreateSubnetOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		resp, err = conn.CreateSubnet(createOpts)
+		return err
+	})
 
 	if err != nil {
 		return fmt.Errorf("Error creating subnet: %s", err)
@@ -95,8 +102,13 @@ func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 func resourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
-	resp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
-		SubnetIds: []*string{aws.String(d.Id())},
+	var resp *ec2.DescribeSubnetsOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		resp, err = conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+			SubnetIds: []*string{aws.String(d.Id())},
+		})
+		return err
 	})
 
 	if err != nil {
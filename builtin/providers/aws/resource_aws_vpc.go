@@ -19,6 +19,9 @@ func resourceAwsVpc() *schema.Resource {
 		Read:   resourceAwsVpcRead,
 		Update: resourceAwsVpcUpdate,
 		Delete: resourceAwsVpcDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": &schema.Schema{
@@ -92,7 +95,12 @@ func resourceAwsVpcCreate(d *schema.ResourceData, meta interface{}) error {
 		InstanceTenancy: aws.String(instance_tenancy),
 	}
 	log.Printf("[DEBUG] VPC create config: %#v", *createOpts)
-	vpcResp, err := conn.CreateVpc(createOpts)
+	var vpcResp *ec2.CreateVpcOutput
+	err := retryOnAwsThrottle(func() error {
+		var err error
+		vpcResp, err = conn.CreateVpc(createOpts)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating VPC: %s", err)
 	}
@@ -0,0 +1,113 @@
+package aws
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// awsThrottleRetries is how many times retryOnAwsThrottle retries a call
+// after the SDK gave up on it. Every attempt is already retried by the
+// SDK up to max_retries times, so this is kept small.
+const awsThrottleRetries = 3
+
+// awsThrottleCodes are the error codes AWS services use to signal that
+// a request was rate limited.
+var awsThrottleCodes = map[string]struct{}{
+	"Throttling":                             struct{}{},
+	"ThrottlingException":                    struct{}{},
+	"RequestLimitExceeded":                   struct{}{},
+	"RequestThrottled":                       struct{}{},
+	"SlowDown":                               struct{}{},
+	"TooManyRequestsException":               struct{}{},
+	"ProvisionedThroughputExceededException": struct{}{},
+}
+
+// isAWSThrottleErr returns true if the error is AWS rate limiting the
+// request.
+func isAWSThrottleErr(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+
+	_, ok = awsThrottleCodes[awsErr.Code()]
+	return ok
+}
+
+// retryOnAwsThrottle calls f, retrying it with an exponential backoff for
+// as long as AWS is throttling it, up to awsThrottleRetries times. Any
+// other error is returned right away.
+//
+// The SDK already retries throttled requests itself, but with short
+// delays. When many resources are being created in parallel this isn't
+// always enough, so calls that are made a lot should be wrapped in this.
+func retryOnAwsThrottle(f resource.RetryFunc) error {
+	conf := &resource.BackoffConf{
+		MaxRetries: awsThrottleRetries,
+		MinDelay:   2 * time.Second,
+		MaxDelay:   15 * time.Second,
+		Retryable:  isAWSThrottleErr,
+	}
+
+	return conf.Retry(f)
+}
+
+// requestLimiter spaces out the requests sent to AWS so that no more
+// than a given number of them are sent per second across all the
+// resources of the provider. This is set with max_requests_per_second.
+type requestLimiter struct {
+	interval time.Duration
+
+	// now and sleep default to time.Now and time.Sleep, and are only
+	// replaced by tests.
+	now   func() time.Time
+	sleep func(time.Duration)
+
+	l    sync.Mutex
+	next time.Time
+}
+
+func newRequestLimiter(perSecond int) *requestLimiter {
+	return &requestLimiter{
+		interval: time.Second / time.Duration(perSecond),
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+// Wait blocks until the next request may be sent.
+func (l *requestLimiter) Wait() {
+	l.l.Lock()
+	now := l.now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.l.Unlock()
+
+	if wait > 0 {
+		l.sleep(wait)
+	}
+}
+
+// Handler is a request handler that waits for the limiter before each
+// request is sent, including the retries made by the SDK.
+func (l *requestLimiter) Handler(r *request.Request) {
+	l.Wait()
+}
+
+// limitRequests makes all the requests sent with the session wait for
+// the limiter. Nothing is limited if the limiter is nil.
+func limitRequests(sess *session.Session, l *requestLimiter) {
+	if l == nil {
+		return
+	}
+
+	sess.Handlers.Send.PushFront(l.Handler)
+}
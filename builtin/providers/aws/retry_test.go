@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestIsAWSThrottleErr(t *testing.T) {
+	cases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{nil, false},
+		{fmt.Errorf("Throttling"), false},
+		{awserr.New("Throttling", "Rate exceeded", nil), true},
+		{awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil), true},
+		{awserr.New("SlowDown", "Please reduce your request rate.", nil), true},
+		{awserr.New("InvalidVpcID.NotFound", "not found", nil), false},
+	}
+
+	for i, tc := range cases {
+		if actual := isAWSThrottleErr(tc.Err); actual != tc.Expected {
+			t.Fatalf("%d: expected %t for %#v", i, tc.Expected, tc.Err)
+		}
+	}
+}
+
+func TestRequestLimiter(t *testing.T) {
+	l := newRequestLimiter(20)
+
+	// Use a fake clock that only moves forward when the limiter sleeps
+	clock := time.Unix(0, 0)
+	var sleeps []time.Duration
+	l.now = func() time.Time { return clock }
+	l.sleep = func(d time.Duration) {
+		sleeps = append(sleeps, d)
+		clock = clock.Add(d)
+	}
+
+	for i := 0; i < 5; i++ {
+		l.Wait()
+	}
+
+	// The first request is sent right away and the other four are
+	// spaced out by 50ms each.
+	expected := []time.Duration{
+		50 * time.Millisecond,
+		50 * time.Millisecond,
+		50 * time.Millisecond,
+		50 * time.Millisecond,
+	}
+	if !reflect.DeepEqual(sleeps, expected) {
+		t.Fatalf("bad: %#v", sleeps)
+	}
+
+	// Once enough time has passed, the next request isn't delayed
+	clock = clock.Add(time.Second)
+	sleeps = nil
+	l.Wait()
+	if len(sleeps) != 0 {
+		t.Fatalf("bad: %#v", sleeps)
+	}
+}
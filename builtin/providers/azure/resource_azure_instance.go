@@ -127,9 +127,10 @@ func resourceAzureInstance() *schema.Resource {
 			},
 
 			"password": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 
 			"ssh_key_thumbprint": &schema.Schema{
@@ -198,9 +199,10 @@ func resourceAzureInstance() *schema.Resource {
 			},
 
 			"domain_password": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 
 			"domain_ou": &schema.Schema{
@@ -33,9 +33,10 @@ func resourceAzureSqlDatabaseServer() *schema.Resource {
 				ForceNew: true,
 			},
 			"password": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 			"version": &schema.Schema{
 				Type:     schema.TypeString,
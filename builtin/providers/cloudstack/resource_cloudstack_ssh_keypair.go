@@ -31,8 +31,9 @@ func resourceCloudStackSSHKeyPair() *schema.Resource {
 			},
 
 			"private_key": &schema.Schema{
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
 			"fingerprint": &schema.Schema{
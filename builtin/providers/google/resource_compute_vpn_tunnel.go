@@ -38,9 +38,10 @@ func resourceComputeVpnTunnel() *schema.Resource {
 				ForceNew: true,
 			},
 			"shared_secret": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 			"target_vpn_gateway": &schema.Schema{
 				Type:     schema.TypeString,
@@ -94,9 +94,10 @@ func resourceContainerCluster() *schema.Resource {
 						},
 
 						"password": &schema.Schema{
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
 						},
 
 						"username": &schema.Schema{
@@ -29,9 +29,10 @@ func resourceMailgunDomain() *schema.Resource {
 			},
 
 			"smtp_password": &schema.Schema{
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Required: true,
+				Type:      schema.TypeString,
+				ForceNew:  true,
+				Required:  true,
+				Sensitive: true,
 			},
 
 			"smtp_login": &schema.Schema{
@@ -53,6 +53,7 @@ func resourceCertRequest() *schema.Resource {
 				StateFunc: func(v interface{}) string {
 					return hashForState(v.(string))
 				},
+				Sensitive: true,
 			},
 
 			"subject": &schema.Schema{
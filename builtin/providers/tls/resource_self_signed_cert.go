@@ -114,6 +114,7 @@ func resourceSelfSignedCert() *schema.Resource {
 				StateFunc: func(v interface{}) string {
 					return hashForState(v.(string))
 				},
+				Sensitive: true,
 			},
 
 			"subject": &schema.Schema{
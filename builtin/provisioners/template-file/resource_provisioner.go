@@ -0,0 +1,188 @@
+package templatefile
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/communicator"
+	tfconfig "github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/config/lang"
+	"github.com/hashicorp/terraform/config/lang/ast"
+	"github.com/hashicorp/terraform/helper/config"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/go-homedir"
+)
+
+// ResourceProvisioner represents a template-file provisioner. It renders
+// a local template and uploads the result to the remote machine.
+type ResourceProvisioner struct{}
+
+// Apply renders the template and uploads it to the destination
+func (p *ResourceProvisioner) Apply(
+	o terraform.UIOutput,
+	s *terraform.InstanceState,
+	c *terraform.ResourceConfig) error {
+	// Get a new communicator
+	comm, err := communicator.New(s)
+	if err != nil {
+		return err
+	}
+
+	// Get the source and destination
+	src, ok := c.Config["source"].(string)
+	if !ok {
+		return fmt.Errorf("Unsupported 'source' type! Must be string.")
+	}
+	dst, ok := c.Config["destination"].(string)
+	if !ok {
+		return fmt.Errorf("Unsupported 'destination' type! Must be string.")
+	}
+
+	vars, err := decodeVars(c.Config["vars"])
+	if err != nil {
+		return err
+	}
+
+	rendered, err := renderFile(src, vars)
+	if err != nil {
+		return err
+	}
+
+	// Wait and retry until we establish the connection
+	err = retryFunc(comm.Timeout(), func() error {
+		return comm.Connect(nil)
+	})
+	if err != nil {
+		return err
+	}
+	defer comm.Disconnect()
+
+	o.Output(fmt.Sprintf("Uploading rendered %s to %s", src, dst))
+	if err := comm.Upload(dst, strings.NewReader(rendered)); err != nil {
+		return fmt.Errorf("Upload failed: %v", err)
+	}
+
+	return nil
+}
+
+// Validate checks if the required arguments are configured
+func (p *ResourceProvisioner) Validate(c *terraform.ResourceConfig) (ws []string, es []error) {
+	v := &config.Validator{
+		Required: []string{
+			"source",
+			"destination",
+		},
+		Optional: []string{
+			"vars.*",
+		},
+	}
+	return v.Validate(c)
+}
+
+// decodeVars turns the raw "vars" configuration into a single map. The
+// configuration loader gives us a list of maps for blocks, so these are
+// merged together.
+func decodeVars(raw interface{}) (map[string]string, error) {
+	result := make(map[string]string)
+	if raw == nil {
+		return result, nil
+	}
+
+	var maps []map[string]interface{}
+	switch v := raw.(type) {
+	case map[string]interface{}:
+		maps = []map[string]interface{}{v}
+	case []map[string]interface{}:
+		maps = v
+	default:
+		return nil, fmt.Errorf("Unsupported 'vars' type! Must be a map.")
+	}
+
+	for _, m := range maps {
+		for k, v := range m {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf(
+					"unexpected type for variable %q: %T", k, v)
+			}
+			result[k] = s
+		}
+	}
+
+	return result, nil
+}
+
+// renderFile reads the template at path and renders it with vars.
+func renderFile(path string, vars map[string]string) (string, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return "", err
+	}
+
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	rendered, err := render(string(buf), vars)
+	if err != nil {
+		return "", fmt.Errorf("failed to render %s: %s", path, err)
+	}
+
+	return rendered, nil
+}
+
+// render parses and executes a template using the same interpolation
+// syntax and functions as the Terraform configuration.
+func render(s string, vars map[string]string) (string, error) {
+	root, err := lang.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	varmap := make(map[string]ast.Variable)
+	for k, v := range vars {
+		varmap[k] = ast.Variable{
+			Value: v,
+			Type:  ast.TypeString,
+		}
+	}
+
+	cfg := lang.EvalConfig{
+		GlobalScope: &ast.BasicScope{
+			VarMap:  varmap,
+			FuncMap: tfconfig.Funcs,
+		},
+	}
+
+	out, typ, err := lang.Eval(root, &cfg)
+	if err != nil {
+		return "", err
+	}
+	if typ != ast.TypeString {
+		return "", fmt.Errorf("unexpected output ast.Type: %v", typ)
+	}
+
+	return out.(string), nil
+}
+
+// retryFunc is used to retry a function for a given duration
+func retryFunc(timeout time.Duration, f func() error) error {
+	finish := time.After(timeout)
+	for {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		log.Printf("Retryable error: %v", err)
+
+		select {
+		case <-finish:
+			return err
+		case <-time.After(3 * time.Second):
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package templatefile
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceProvisioner_impl(t *testing.T) {
+	var _ terraform.ResourceProvisioner = new(ResourceProvisioner)
+}
+
+func TestResourceProvider_Validate_good(t *testing.T) {
+	c := testConfig(t, map[string]interface{}{
+		"source":      "/tmp/foo.tpl",
+		"destination": "/tmp/bar",
+		"vars": []map[string]interface{}{
+			map[string]interface{}{
+				"name": "web",
+			},
+		},
+	})
+	p := new(ResourceProvisioner)
+	warn, errs := p.Validate(c)
+	if len(warn) > 0 {
+		t.Fatalf("Warnings: %v", warn)
+	}
+	if len(errs) > 0 {
+		t.Fatalf("Errors: %v", errs)
+	}
+}
+
+func TestResourceProvider_Validate_bad(t *testing.T) {
+	c := testConfig(t, map[string]interface{}{
+		"source": "nope",
+	})
+	p := new(ResourceProvisioner)
+	warn, errs := p.Validate(c)
+	if len(warn) > 0 {
+		t.Fatalf("Warnings: %v", warn)
+	}
+	if len(errs) == 0 {
+		t.Fatalf("Should have errors")
+	}
+}
+
+func TestDecodeVars(t *testing.T) {
+	cases := []struct {
+		Raw      interface{}
+		Expected map[string]string
+		Err      bool
+	}{
+		{
+			nil,
+			map[string]string{},
+			false,
+		},
+		{
+			map[string]interface{}{"a": "1"},
+			map[string]string{"a": "1"},
+			false,
+		},
+		{
+			[]map[string]interface{}{
+				map[string]interface{}{"a": "1"},
+				map[string]interface{}{"b": "2"},
+			},
+			map[string]string{"a": "1", "b": "2"},
+			false,
+		},
+		{
+			map[string]interface{}{"a": []interface{}{"1"}},
+			nil,
+			true,
+		},
+		{
+			"nope",
+			nil,
+			true,
+		},
+	}
+
+	for i, tc := range cases {
+		actual, err := decodeVars(tc.Raw)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if tc.Err {
+			continue
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestRenderFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "tf")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString(`listen ${port}; name ${upper(name)};`)
+	f.Close()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual, err := renderFile(f.Name(), map[string]string{
+		"port": "80",
+		"name": "web",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := "listen 80; name WEB;"
+	if actual != expected {
+		t.Fatalf("bad: %q", actual)
+	}
+}
+
+func TestRenderFile_missingVar(t *testing.T) {
+	f, err := ioutil.TempFile("", "tf")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString(`listen ${port};`)
+	f.Close()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := renderFile(f.Name(), nil); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func testConfig(
+	t *testing.T,
+	c map[string]interface{}) *terraform.ResourceConfig {
+	r, err := config.NewRawConfig(c)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+	return terraform.NewResourceConfig(r)
+}
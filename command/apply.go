@@ -26,7 +26,7 @@ type ApplyCommand struct {
 }
 
 func (c *ApplyCommand) Run(args []string) int {
-	var destroyForce, refresh bool
+	var destroyForce, planForce, refresh bool
 	args = c.Meta.process(args, true)
 
 	cmdName := "apply"
@@ -37,13 +37,16 @@ func (c *ApplyCommand) Run(args []string) int {
 	cmdFlags := c.Meta.flagSet(cmdName)
 	if c.Destroy {
 		cmdFlags.BoolVar(&destroyForce, "force", false, "force")
+	} else {
+		cmdFlags.BoolVar(&planForce, "force", false, "force")
 	}
 	cmdFlags.BoolVar(&refresh, "refresh", true, "refresh")
 	cmdFlags.IntVar(
 		&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
-	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.DurationVar(&c.Meta.lockTimeout, "lock-timeout", 0, "lock-timeout")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -96,15 +99,18 @@ func (c *ApplyCommand) Run(args []string) int {
 
 	// Build the context based on the arguments given
 	ctx, planned, err := c.Context(contextOpts{
-		Destroy:     c.Destroy,
-		Path:        configPath,
-		StatePath:   c.Meta.statePath,
-		Parallelism: c.Meta.parallelism,
+		Destroy:        c.Destroy,
+		Path:           configPath,
+		StatePath:      c.Meta.statePath,
+		Parallelism:    c.Meta.parallelism,
+		LockReason:     cmdName,
+		CheckPlanState: !planForce,
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
 		return 1
 	}
+	defer c.Meta.unlockState()
 	if c.Destroy && planned {
 		c.Ui.Error(fmt.Sprintf(
 			"Destroy can't be called with a plan file."))
@@ -277,8 +283,14 @@ Options:
                          modifying. Defaults to the "-state-out" path with
                          ".backup" extension. Set to "-" to disable backup.
 
+  -force                 Apply a plan file even if the state has changed
+                         since the plan was created.
+
   -input=true            Ask for input for variables if not directly set.
 
+  -lock-timeout=0s       Duration to retry acquiring the state lock if it
+                         is held by another Terraform run.
+
   -no-color              If specified, output won't contain any color.
 
   -parallelism=n         Limit the number of concurrent operations.
@@ -324,6 +336,9 @@ Options:
 
   -force                 Don't ask for input for destroy confirmation.
 
+  -lock-timeout=0s       Duration to retry acquiring the state lock if it
+                         is held by another Terraform run.
+
   -no-color              If specified, output won't contain any color.
 
   -parallelism=n         Limit the number of concurrent operations.
@@ -360,7 +375,8 @@ func outputsAsString(state *terraform.State) string {
 		return ""
 	}
 
-	outputs := state.RootModule().Outputs
+	root := state.RootModule()
+	outputs := root.Outputs
 	outputBuf := new(bytes.Buffer)
 	if len(outputs) > 0 {
 		outputBuf.WriteString("[reset][bold][green]\nOutputs:\n\n")
@@ -378,6 +394,9 @@ func outputsAsString(state *terraform.State) string {
 
 		for _, k := range keys {
 			v := outputs[k]
+			if root.OutputSensitive(k) {
+				v = sensitiveValue
+			}
 
 			outputBuf.WriteString(fmt.Sprintf(
 				"  %s%s = %s\n",
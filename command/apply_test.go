@@ -16,6 +16,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform/state"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mitchellh/cli"
 )
@@ -117,6 +118,40 @@ func TestApply_parallelism(t *testing.T) {
 	}
 }
 
+func TestApply_locked(t *testing.T) {
+	statePath := testStateFile(t, testState())
+
+	// Hold the lock as if another apply were running
+	ls := &state.LocalState{Path: statePath}
+	if err := ls.Lock("test"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer ls.Unlock()
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		testFixturePath("apply"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "state lock") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+	if p.ApplyCalled {
+		t.Fatal("apply should not be called")
+	}
+}
+
 func TestApply_configInvalid(t *testing.T) {
 	p := testProvider()
 	ui := new(cli.MockUi)
@@ -460,6 +495,74 @@ func TestApply_plan(t *testing.T) {
 	}
 }
 
+func TestApply_planStateChanged(t *testing.T) {
+	planPath := testPlanFile(t, &terraform.Plan{
+		Module: testModule(t, "apply"),
+		State:  &terraform.State{Serial: 1},
+	})
+
+	originalState := testState()
+	originalState.Serial = 2
+	statePath := testStateFile(t, originalState)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		planPath,
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "state has changed") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+
+	if p.ApplyCalled {
+		t.Fatal("apply should not be called")
+	}
+}
+
+func TestApply_planStateChangedForce(t *testing.T) {
+	planPath := testPlanFile(t, &terraform.Plan{
+		Module: testModule(t, "apply"),
+		State:  &terraform.State{Serial: 1},
+	})
+
+	originalState := testState()
+	originalState.Serial = 2
+	statePath := testStateFile(t, originalState)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-force",
+		"-state", statePath,
+		planPath,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	if !strings.Contains(ui.OutputWriter.String(), "Apply complete") {
+		t.Fatalf("bad: %s", ui.OutputWriter.String())
+	}
+}
+
 func TestApply_plan_remoteState(t *testing.T) {
 	// Disable test mode so input would be asked
 	test = false
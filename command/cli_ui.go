@@ -49,3 +49,34 @@ func (u *ColorizeUi) colorize(message string, color string) string {
 
 	return u.Colorize.Color(fmt.Sprintf("%s%s[reset]", color, message))
 }
+
+// StderrUi is a Ui implementation that writes normal output to the error
+// stream of the wrapped Ui. This keeps human-readable progress messages
+// out of stdout when stdout is used for machine-readable output.
+type StderrUi struct {
+	Ui cli.Ui
+}
+
+func (u *StderrUi) Ask(query string) (string, error) {
+	return u.Ui.Ask(query)
+}
+
+func (u *StderrUi) AskSecret(query string) (string, error) {
+	return u.Ui.AskSecret(query)
+}
+
+func (u *StderrUi) Output(message string) {
+	u.Ui.Error(message)
+}
+
+func (u *StderrUi) Info(message string) {
+	u.Ui.Error(message)
+}
+
+func (u *StderrUi) Error(message string) {
+	u.Ui.Error(message)
+}
+
+func (u *StderrUi) Warn(message string) {
+	u.Ui.Warn(message)
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mitchellh/cli"
@@ -26,10 +27,27 @@ const DefaultBackupExtension = ".backup"
 // by default.
 const DefaultDataDirectory = ".terraform"
 
+// DefaultEnvDir is the directory where the state of environments other
+// than the default environment is stored, in a subdirectory per
+// environment.
+const DefaultEnvDir = "terraform.tfstate.d"
+
+// DefaultEnvFile is the file within the data directory that records the
+// currently selected environment.
+const DefaultEnvFile = "environment"
+
 // DefaultParallelism is the limit Terraform places on total parallel
 // operations as it walks the dependency graph.
 const DefaultParallelism = 10
 
+// sensitiveValue is shown in place of the values of sensitive outputs
+// and attributes.
+const sensitiveValue = "<sensitive>"
+
+// lockRetryInterval is how long to wait between attempts to acquire the
+// state lock while it is held elsewhere.
+const lockRetryInterval = 1 * time.Second
+
 func validateContext(ctx *terraform.Context, ui cli.Ui) bool {
 	if ws, es := ctx.Validate(); len(ws) > 0 || len(es) > 0 {
 		ui.Output(
@@ -0,0 +1,150 @@
+package command
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// EnvCommand is a Command implementation that dispatches to the
+// subcommands for managing environments.
+type EnvCommand struct {
+	Meta
+}
+
+func (c *EnvCommand) Run(argsRaw []string) int {
+	// Duplicate the args so we can munge them without affecting
+	// future subcommand invocations which will do the same.
+	args := make([]string, len(argsRaw))
+	copy(args, argsRaw)
+	args = c.Meta.process(args, false)
+
+	if len(args) == 0 {
+		c.Ui.Error(c.Help())
+		return 1
+	}
+
+	switch args[0] {
+	case "delete":
+		cmd := &EnvDeleteCommand{Meta: c.Meta}
+		return cmd.Run(args[1:])
+	case "list":
+		cmd := &EnvListCommand{Meta: c.Meta}
+		return cmd.Run(args[1:])
+	case "new":
+		cmd := &EnvNewCommand{Meta: c.Meta}
+		return cmd.Run(args[1:])
+	case "select":
+		cmd := &EnvSelectCommand{Meta: c.Meta}
+		return cmd.Run(args[1:])
+	default:
+		c.Ui.Error(c.Help())
+		return 1
+	}
+}
+
+func (c *EnvCommand) Help() string {
+	helpText := `
+Usage: terraform env <subcommand> [options] [args]
+
+  Manage environments.
+
+  Environments are separate instances of the same configuration, such as
+  "dev", "stage" and "prod", that each have their own state. The state of
+  the "default" environment is stored in "terraform.tfstate" as usual.
+  The state of any other environment is stored in its own directory
+  within "terraform.tfstate.d". Remote state is configured separately
+  for each environment with "terraform remote config".
+
+  The name of the current environment is available in the configuration
+  as "${terraform.env}".
+
+Available subcommands:
+
+  delete      Delete an environment.
+  list        List environments.
+  new         Create a new environment and select it.
+  select      Select an environment.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvCommand) Synopsis() string {
+	return "Environment management"
+}
+
+// envDirs returns the directories that hold the local state and the
+// remote state cache of the environment with the given name.
+func (m *Meta) envDirs(name string) []string {
+	return []string{
+		filepath.Join(DefaultEnvDir, name),
+		filepath.Join(m.DataDir(), DefaultEnvDir, name),
+	}
+}
+
+// envList returns the names of all environments, starting with the
+// default environment.
+func (m *Meta) envList() ([]string, error) {
+	seen := make(map[string]struct{})
+	for _, dir := range m.envDirs("") {
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		for _, info := range infos {
+			if info.IsDir() {
+				seen[info.Name()] = struct{}{}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name, _ := range seen {
+		if name != terraform.DefaultEnvironment {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return append([]string{terraform.DefaultEnvironment}, names...), nil
+}
+
+// envExists returns whether the environment with the given name exists.
+func (m *Meta) envExists(name string) (bool, error) {
+	names, err := m.envList()
+	if err != nil {
+		return false, err
+	}
+
+	for _, n := range names {
+		if n == name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// validateEnvName returns an error if the name can't be used for an
+// environment.
+func validateEnvName(name string) error {
+	if !config.NameRegexp.MatchString(name) {
+		return fmt.Errorf(
+			"Invalid environment name %q. Environment names may only\n"+
+				"contain letters, numbers, dashes and underscores.", name)
+	}
+
+	return nil
+}
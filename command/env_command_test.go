@@ -0,0 +1,128 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
+)
+
+func TestEnv_lifecycle(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	// Create a new environment, which also selects it
+	ui := new(cli.MockUi)
+	newCmd := &EnvNewCommand{Meta: Meta{Ui: ui}}
+	if code := newCmd.Run([]string{"prod"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if env := newCmd.Env(); env != "prod" {
+		t.Fatalf("bad: %s", env)
+	}
+
+	// Creating it again is an error
+	ui = new(cli.MockUi)
+	newCmd = &EnvNewCommand{Meta: Meta{Ui: ui}}
+	if code := newCmd.Run([]string{"prod"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	// List the environments
+	ui = new(cli.MockUi)
+	listCmd := &EnvListCommand{Meta: Meta{Ui: ui}}
+	if code := listCmd.Run(nil); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	actual := strings.TrimSpace(ui.OutputWriter.String())
+	expected := "default\n* prod"
+	if actual != expected {
+		t.Fatalf("bad:\n\n%s", actual)
+	}
+
+	// The current environment can't be deleted
+	ui = new(cli.MockUi)
+	deleteCmd := &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := deleteCmd.Run([]string{"prod"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	// Select the default environment
+	ui = new(cli.MockUi)
+	selectCmd := &EnvSelectCommand{Meta: Meta{Ui: ui}}
+	if code := selectCmd.Run([]string{"default"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if env := selectCmd.Env(); env != terraform.DefaultEnvironment {
+		t.Fatalf("bad: %s", env)
+	}
+
+	// Delete the environment
+	ui = new(cli.MockUi)
+	deleteCmd = &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := deleteCmd.Run([]string{"prod"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if _, err := os.Stat(filepath.Join(DefaultEnvDir, "prod")); !os.IsNotExist(err) {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Selecting it is now an error
+	ui = new(cli.MockUi)
+	selectCmd = &EnvSelectCommand{Meta: Meta{Ui: ui}}
+	if code := selectCmd.Run([]string{"prod"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+}
+
+func TestEnvNew_invalidName(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	ui := new(cli.MockUi)
+	c := &EnvNewCommand{Meta: Meta{Ui: ui}}
+	if code := c.Run([]string{"../prod"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+}
+
+func TestEnvDelete_resources(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	// Write a state with resources for the environment
+	path := filepath.Join(DefaultEnvDir, "prod", DefaultStateFilename)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	err = terraform.WriteState(testState(), f)
+	f.Close()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui := new(cli.MockUi)
+	c := &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := c.Run([]string{"prod"}); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui = new(cli.MockUi)
+	c = &EnvDeleteCommand{Meta: Meta{Ui: ui}}
+	if code := c.Run([]string{"-force", "prod"}); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("err: %s", err)
+	}
+}
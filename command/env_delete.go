@@ -0,0 +1,128 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform/state"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// EnvDeleteCommand is a Command implementation that deletes an
+// environment.
+type EnvDeleteCommand struct {
+	Meta
+}
+
+func (c *EnvDeleteCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	var force bool
+	cmdFlags := c.Meta.flagSet("env delete")
+	cmdFlags.BoolVar(&force, "force", false, "force")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("The env delete command expects exactly one argument.")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	name := args[0]
+	if name == terraform.DefaultEnvironment {
+		c.Ui.Error("The default environment can't be deleted.")
+		return 1
+	}
+	if name == c.Env() {
+		c.Ui.Error(fmt.Sprintf(
+			"Environment %q is the current environment. Select another\n"+
+				"environment before deleting it.", name))
+		return 1
+	}
+
+	exists, err := c.envExists(name)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing environments: %s", err))
+		return 1
+	}
+	if !exists {
+		c.Ui.Error(fmt.Sprintf("Environment %q doesn't exist.", name))
+		return 1
+	}
+
+	dirs := c.envDirs(name)
+	if !force {
+		for _, dir := range dirs {
+			ls := &state.LocalState{
+				Path: filepath.Join(dir, DefaultStateFilename),
+			}
+			if err := ls.RefreshState(); err != nil {
+				c.Ui.Error(fmt.Sprintf("Error reading state: %s", err))
+				return 1
+			}
+
+			if stateHasResources(ls.State()) {
+				c.Ui.Error(fmt.Sprintf(
+					"Environment %q still has resources in its state. Destroy\n"+
+						"them first, or use the -force flag to delete the environment\n"+
+						"anyway. Terraform will then no longer manage the resources.",
+					name))
+				return 1
+			}
+		}
+	}
+
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			c.Ui.Error(fmt.Sprintf("Error deleting environment: %s", err))
+			return 1
+		}
+	}
+
+	c.Ui.Output(fmt.Sprintf("Deleted environment %q.", name))
+	return 0
+}
+
+// stateHasResources returns whether any module in the state has
+// resources.
+func stateHasResources(s *terraform.State) bool {
+	if s == nil {
+		return false
+	}
+
+	for _, m := range s.Modules {
+		if len(m.Resources) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *EnvDeleteCommand) Help() string {
+	helpText := `
+Usage: terraform env delete [options] NAME
+
+  Delete an environment and its local state.
+
+  The current environment and the default environment can't be deleted.
+  If the environment uses remote state, the state stored remotely is
+  not deleted.
+
+Options:
+
+  -force              Delete the environment even if its state still
+                      has resources in it.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvDeleteCommand) Synopsis() string {
+	return "Delete an environment"
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EnvListCommand is a Command implementation that lists the environments.
+type EnvListCommand struct {
+	Meta
+}
+
+func (c *EnvListCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("env list")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	names, err := c.envList()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing environments: %s", err))
+		return 1
+	}
+
+	current := c.Env()
+	for _, name := range names {
+		if name == current {
+			c.Ui.Output("* " + name)
+		} else {
+			c.Ui.Output("  " + name)
+		}
+	}
+
+	return 0
+}
+
+func (c *EnvListCommand) Help() string {
+	helpText := `
+Usage: terraform env list
+
+  List the environments. The current environment is marked with an
+  asterisk.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvListCommand) Synopsis() string {
+	return "List environments"
+}
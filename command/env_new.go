@@ -0,0 +1,75 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvNewCommand is a Command implementation that creates a new
+// environment and selects it.
+type EnvNewCommand struct {
+	Meta
+}
+
+func (c *EnvNewCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("env new")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("The env new command expects exactly one argument.")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	name := args[0]
+	if err := validateEnvName(name); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	exists, err := c.envExists(name)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing environments: %s", err))
+		return 1
+	}
+	if exists {
+		c.Ui.Error(fmt.Sprintf("Environment %q already exists.", name))
+		return 1
+	}
+
+	if err := os.MkdirAll(c.envDirs(name)[0], 0755); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error creating environment: %s", err))
+		return 1
+	}
+	if err := c.SetEnv(name); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error selecting environment: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf(
+		"Created and selected environment %q. It has an empty state.", name))
+	return 0
+}
+
+func (c *EnvNewCommand) Help() string {
+	helpText := `
+Usage: terraform env new NAME
+
+  Create a new environment with an empty state and select it.
+
+  To use remote state for the new environment, configure it with
+  "terraform remote config" after creating the environment.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvNewCommand) Synopsis() string {
+	return "Create a new environment"
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EnvSelectCommand is a Command implementation that selects the current
+// environment.
+type EnvSelectCommand struct {
+	Meta
+}
+
+func (c *EnvSelectCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("env select")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("The env select command expects exactly one argument.")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	name := args[0]
+	exists, err := c.envExists(name)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error listing environments: %s", err))
+		return 1
+	}
+	if !exists {
+		c.Ui.Error(fmt.Sprintf(
+			"Environment %q doesn't exist. Create it with \"terraform env new\".",
+			name))
+		return 1
+	}
+
+	if err := c.SetEnv(name); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error selecting environment: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(fmt.Sprintf("Selected environment %q.", name))
+	return 0
+}
+
+func (c *EnvSelectCommand) Help() string {
+	helpText := `
+Usage: terraform env select NAME
+
+  Select the environment that subsequent commands operate on.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *EnvSelectCommand) Synopsis() string {
+	return "Select an environment"
+}
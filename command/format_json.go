@@ -0,0 +1,267 @@
+package command
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/dag"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// jsonFormatVersion is the version of the JSON output format. It is
+// increased whenever the format changes in a way that isn't backwards
+// compatible, so that tools consuming it can detect the change.
+const jsonFormatVersion = "1"
+
+// jsonPlan is the machine-readable representation of a plan.
+type jsonPlan struct {
+	FormatVersion string                `json:"format_version"`
+	Changes       []*jsonResourceChange `json:"resource_changes"`
+	State         *jsonState            `json:"prior_state"`
+}
+
+// jsonResourceChange is a single resource in the plan diff.
+type jsonResourceChange struct {
+	Address    string                          `json:"address"`
+	Module     string                          `json:"module,omitempty"`
+	Action     string                          `json:"action"`
+	Attributes map[string]*jsonAttributeChange `json:"attributes"`
+}
+
+// jsonAttributeChange is the change of a single attribute of a resource.
+type jsonAttributeChange struct {
+	Old         string `json:"old"`
+	New         string `json:"new"`
+	NewComputed bool   `json:"new_computed"`
+	RequiresNew bool   `json:"requires_new"`
+	Sensitive   bool   `json:"sensitive"`
+}
+
+// jsonState is the machine-readable representation of a state.
+type jsonState struct {
+	FormatVersion string            `json:"format_version"`
+	Serial        int64             `json:"serial"`
+	Resources     []*jsonResource   `json:"resources"`
+	Outputs       map[string]string `json:"outputs"`
+}
+
+// jsonResource is a single resource in the state.
+type jsonResource struct {
+	Address    string            `json:"address"`
+	Module     string            `json:"module,omitempty"`
+	Type       string            `json:"type"`
+	ID         string            `json:"id"`
+	Tainted    bool              `json:"tainted"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// jsonGraph is the machine-readable representation of a graph as an
+// adjacency list.
+type jsonGraph struct {
+	FormatVersion string           `json:"format_version"`
+	Nodes         []*jsonGraphNode `json:"nodes"`
+	Cycles        [][]string       `json:"cycles"`
+}
+
+// jsonGraphNode is a single node in the graph along with the names of
+// the nodes that it depends on.
+type jsonGraphNode struct {
+	Name         string   `json:"name"`
+	Module       string   `json:"module,omitempty"`
+	Dependencies []string `json:"dependencies"`
+}
+
+// FormatPlanJSON returns the plan as indented JSON, including the state
+// that the plan was created from.
+func FormatPlanJSON(p *terraform.Plan) (string, error) {
+	result := &jsonPlan{
+		FormatVersion: jsonFormatVersion,
+		Changes:       make([]*jsonResourceChange, 0),
+		State:         newJSONState(p.State),
+	}
+
+	if p.Diff != nil {
+		for _, m := range p.Diff.Modules {
+			names := make([]string, 0, len(m.Resources))
+			for name, _ := range m.Resources {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				rdiff := m.Resources[name]
+				if rdiff.Empty() {
+					continue
+				}
+
+				change := &jsonResourceChange{
+					Address:    resourceAddressString(m.Path, name),
+					Module:     jsonModuleName(m.Path),
+					Action:     jsonDiffAction(name, rdiff),
+					Attributes: make(map[string]*jsonAttributeChange),
+				}
+				for k, attr := range rdiff.Attributes {
+					ac := &jsonAttributeChange{
+						Old:         attr.Old,
+						New:         attr.New,
+						NewComputed: attr.NewComputed,
+						RequiresNew: attr.RequiresNew,
+						Sensitive:   attr.Sensitive,
+					}
+					if attr.Sensitive {
+						ac.Old = sensitiveValue
+						ac.New = sensitiveValue
+					}
+
+					change.Attributes[k] = ac
+				}
+
+				result.Changes = append(result.Changes, change)
+			}
+		}
+	}
+
+	return jsonString(result)
+}
+
+// FormatStateJSON returns the state as indented JSON.
+func FormatStateJSON(s *terraform.State) (string, error) {
+	return jsonString(newJSONState(s))
+}
+
+// FormatGraphJSON returns the graph as indented JSON. Nodes that were
+// flattened into the graph from a module have the address of that module
+// set.
+func FormatGraphJSON(g *terraform.Graph) (string, error) {
+	result := &jsonGraph{
+		FormatVersion: jsonFormatVersion,
+		Nodes:         make([]*jsonGraphNode, 0),
+		Cycles:        make([][]string, 0),
+	}
+
+	for _, v := range g.Vertices() {
+		n := &jsonGraphNode{
+			Name:         dag.VertexName(v),
+			Dependencies: make([]string, 0),
+		}
+		if pn, ok := v.(terraform.GraphNodeSubPath); ok {
+			n.Module = jsonModuleName(pn.Path())
+		}
+		for _, dep := range g.DownEdges(v).List() {
+			n.Dependencies = append(n.Dependencies, dag.VertexName(dep))
+		}
+		sort.Strings(n.Dependencies)
+
+		result.Nodes = append(result.Nodes, n)
+	}
+	sort.Sort(jsonGraphNodes(result.Nodes))
+
+	for _, cycle := range g.Cycles() {
+		names := make([]string, len(cycle))
+		for i, v := range cycle {
+			names[i] = dag.VertexName(v)
+		}
+
+		result.Cycles = append(result.Cycles, names)
+	}
+
+	return jsonString(result)
+}
+
+func newJSONState(s *terraform.State) *jsonState {
+	result := &jsonState{
+		FormatVersion: jsonFormatVersion,
+		Resources:     make([]*jsonResource, 0),
+		Outputs:       make(map[string]string),
+	}
+	if s == nil {
+		return result
+	}
+
+	result.Serial = s.Serial
+	for _, m := range s.Modules {
+		names := make([]string, 0, len(m.Resources))
+		for name, _ := range m.Resources {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			rs := m.Resources[name]
+			r := &jsonResource{
+				Address:    resourceAddressString(m.Path, name),
+				Module:     jsonModuleName(m.Path),
+				Type:       rs.Type,
+				Tainted:    len(rs.Tainted) > 0,
+				Attributes: make(map[string]string),
+			}
+			if rs.Primary != nil {
+				r.ID = rs.Primary.ID
+				for k, v := range rs.Primary.Attributes {
+					if rs.Primary.AttributeSensitive(k) {
+						v = sensitiveValue
+					}
+					r.Attributes[k] = v
+				}
+			}
+
+			result.Resources = append(result.Resources, r)
+		}
+	}
+
+	if root := s.RootModule(); root != nil {
+		for k, v := range root.Outputs {
+			if root.OutputSensitive(k) {
+				v = sensitiveValue
+			}
+			result.Outputs[k] = v
+		}
+	}
+
+	return result
+}
+
+// jsonDiffAction returns the name of the action that the diff for the
+// resource with the given key will perform.
+func jsonDiffAction(key string, d *terraform.InstanceDiff) string {
+	switch d.ChangeType() {
+	case terraform.DiffCreate:
+		if strings.HasPrefix(key, "data.") {
+			return "read"
+		}
+		return "create"
+	case terraform.DiffDestroyCreate:
+		return "replace"
+	case terraform.DiffDestroy:
+		return "destroy"
+	default:
+		return "update"
+	}
+}
+
+// jsonModuleName returns the module address for a module path, or an
+// empty string for the root module.
+func jsonModuleName(path []string) string {
+	if len(path) <= 1 {
+		return ""
+	}
+
+	return "module." + strings.Join(path[1:], ".module.")
+}
+
+func jsonString(v interface{}) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// jsonGraphNodes sorts graph nodes by name.
+type jsonGraphNodes []*jsonGraphNode
+
+func (s jsonGraphNodes) Len() int           { return len(s) }
+func (s jsonGraphNodes) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s jsonGraphNodes) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -78,6 +78,7 @@ func formatPlanModuleExpand(
 			continue
 		}
 
+		isData := strings.HasPrefix(name, "data.")
 		if moduleName != "" {
 			name = moduleName + "." + name
 		}
@@ -99,6 +100,13 @@ func formatPlanModuleExpand(
 			symbol = "-"
 		}
 
+		// Data sources are only ever read, never created, so show that
+		// instead of a create.
+		if isData && symbol == "+" {
+			color = "cyan"
+			symbol = "<="
+		}
+
 		buf.WriteString(opts.Color.Color(fmt.Sprintf(
 			"[%s]%s %s\n",
 			color, symbol, name)))
@@ -129,6 +137,12 @@ func formatPlanModuleExpand(
 				v = "<computed>"
 			}
 
+			old := attrDiff.Old
+			if attrDiff.Sensitive {
+				old = sensitiveValue
+				v = sensitiveValue
+			}
+
 			newResource := ""
 			if attrDiff.RequiresNew && rdiff.Destroy {
 				newResource = opts.Color.Color(" [red](forces new resource)")
@@ -138,7 +152,7 @@ func formatPlanModuleExpand(
 				"    %s:%s %#v => %#v%s\n",
 				attrK,
 				strings.Repeat(" ", keyLen-len(attrK)),
-				attrDiff.Old,
+				old,
 				v,
 				newResource))
 		}
@@ -61,6 +61,9 @@ func FormatState(opts *FormatStateOpts) string {
 		// Output each output k/v pair
 		for _, k := range ks {
 			v := m.Outputs[k]
+			if m.OutputSensitive(k) {
+				v = sensitiveValue
+			}
 			buf.WriteString(fmt.Sprintf("%s = %s\n", k, v))
 		}
 	}
@@ -124,6 +127,9 @@ func formatStateModuleExpand(
 			// Output each attribute
 			for _, ak := range attrKeys {
 				av := is.Attributes[ak]
+				if is.AttributeSensitive(ak) {
+					av = sensitiveValue
+				}
 				buf.WriteString(fmt.Sprintf("  %s = %s\n", ak, av))
 			}
 		}
@@ -19,6 +19,8 @@ func (c *GraphCommand) Run(args []string) int {
 	var moduleDepth int
 	var verbose bool
 	var drawCycles bool
+	var graphTypeStr string
+	var outputJSON bool
 
 	args = c.Meta.process(args, false)
 
@@ -26,6 +28,8 @@ func (c *GraphCommand) Run(args []string) int {
 	c.addModuleDepthFlag(cmdFlags, &moduleDepth)
 	cmdFlags.BoolVar(&verbose, "verbose", false, "verbose")
 	cmdFlags.BoolVar(&drawCycles, "draw-cycles", false, "draw-cycles")
+	cmdFlags.StringVar(&graphTypeStr, "type", "", "type")
+	cmdFlags.BoolVar(&outputJSON, "json", false, "json")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -47,15 +51,40 @@ func (c *GraphCommand) Run(args []string) int {
 		}
 	}
 
-	ctx, _, err := c.Context(contextOpts{
+	switch graphTypeStr {
+	case "", "plan", "plan-destroy", "apply":
+	default:
+		c.Ui.Error(fmt.Sprintf(
+			"Invalid graph type %q. Valid types are \"plan\",\n"+
+				"\"plan-destroy\" and \"apply\".", graphTypeStr))
+		return 1
+	}
+
+	ctx, planFile, err := c.Context(contextOpts{
 		Path:      path,
 		StatePath: "",
+		Destroy:   graphTypeStr == "plan-destroy",
 	})
 	if err != nil {
 		c.Ui.Error(fmt.Sprintf("Error loading Terraform: %s", err))
 		return 1
 	}
 
+	// The apply graph is built from the diff in a plan file, and the plan
+	// graphs from the configuration and the current state.
+	if planFile && graphTypeStr != "" && graphTypeStr != "apply" {
+		c.Ui.Error(fmt.Sprintf(
+			"The %q graph can't be created from a plan file. Give the\n"+
+				"directory with the configuration instead.", graphTypeStr))
+		return 1
+	}
+	if !planFile && graphTypeStr == "apply" {
+		c.Ui.Error(
+			"The \"apply\" graph requires a plan file. Create one with\n" +
+				"`terraform plan -out` and give its path instead.")
+		return 1
+	}
+
 	// Skip validation during graph generation - we want to see the graph even if
 	// it is invalid for some reason.
 	g, err := ctx.Graph(&terraform.ContextGraphOpts{
@@ -67,6 +96,17 @@ func (c *GraphCommand) Run(args []string) int {
 		return 1
 	}
 
+	if outputJSON {
+		graphStr, err := FormatGraphJSON(g)
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error converting graph: %s", err))
+			return 1
+		}
+
+		c.Ui.Output(graphStr)
+		return 0
+	}
+
 	graphStr, err := terraform.GraphDot(g, &terraform.GraphDotOpts{
 		DrawCycles: drawCycles,
 		MaxDepth:   moduleDepth,
@@ -89,22 +129,36 @@ Usage: terraform graph [options] [DIR]
   Outputs the visual dependency graph of Terraform resources according to
   configuration files in DIR (or the current directory if omitted).
 
+  If DIR is a plan file, the graph of the operations that applying the
+  plan will perform is shown instead.
+
   The graph is outputted in DOT format. The typical program that can
   read this format is GraphViz, but many web services are also available
-  to read this format.
+  to read this format. Resources within modules are grouped into a
+  cluster for each module.
 
 Options:
 
   -draw-cycles         Highlight any cycles in the graph with colored edges.
                        This helps when diagnosing cycle errors.
 
+  -json                Output the graph as JSON instead of DOT, listing the
+                       dependencies of every node and any cycles.
+
   -module-depth=n      The maximum depth to expand modules. By default this is
                        zero, which will not expand modules at all.
 
   -verbose             Generate a verbose, "worst-case" graph, with all nodes
                        for potential operations in place.
 
-  -no-color           If specified, output won't contain any color.
+  -no-color            If specified, output won't contain any color.
+
+  -type=plan           Type of graph to output. "plan" and "plan-destroy"
+                       are the graphs walked by "terraform plan" and
+                       "terraform plan -destroy". "apply" is the graph
+                       walked when applying a plan, and requires DIR to be
+                       a plan file. By default, "apply" is used for a plan
+                       file and "plan" otherwise.
 
 `
 	return strings.TrimSpace(helpText)
@@ -1,7 +1,9 @@
 package command
 
 import (
+	"encoding/json"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -103,3 +105,86 @@ func TestGraph_plan(t *testing.T) {
 		t.Fatalf("doesn't look like digraph: %s", output)
 	}
 }
+
+func TestGraph_json(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var result struct {
+		Nodes []struct {
+			Name         string
+			Dependencies []string
+		}
+	}
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &result); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	found := false
+	for _, n := range result.Nodes {
+		if n.Name != "test_instance.foo" {
+			continue
+		}
+
+		found = true
+		if !reflect.DeepEqual(n.Dependencies, []string{"provider.test"}) {
+			t.Fatalf("bad: %#v", n.Dependencies)
+		}
+	}
+	if !found {
+		t.Fatalf("resource not found: %s", ui.OutputWriter.String())
+	}
+}
+
+func TestGraph_applyNoPlan(t *testing.T) {
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-type=apply",
+		testFixturePath("graph"),
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: \n%s", ui.OutputWriter.String())
+	}
+}
+
+func TestGraph_planTypeWithPlan(t *testing.T) {
+	planPath := testPlanFile(t, &terraform.Plan{
+		Module: testModule(t, "graph"),
+	})
+
+	ui := new(cli.MockUi)
+	c := &GraphCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-type=plan",
+		planPath,
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: \n%s", ui.OutputWriter.String())
+	}
+}
@@ -94,11 +94,17 @@ func (h *UiHook) PreApply(
 			v = "<computed>"
 		}
 
+		old := attrDiff.Old
+		if attrDiff.Sensitive {
+			old = sensitiveValue
+			v = sensitiveValue
+		}
+
 		attrBuf.WriteString(fmt.Sprintf(
 			"  %s:%s %#v => %#v\n",
 			attrK,
 			strings.Repeat(" ", keyLen-len(attrK)),
-			attrDiff.Old,
+			old,
 			v))
 	}
 
@@ -200,6 +206,34 @@ func (h *UiHook) PreRefresh(
 	return terraform.HookActionContinue, nil
 }
 
+func (h *UiHook) PreImportState(
+	n *terraform.InstanceInfo,
+	id string) (terraform.HookAction, error) {
+	h.once.Do(h.init)
+
+	h.ui.Output(h.Colorize.Color(fmt.Sprintf(
+		"[reset][bold]%s: Importing from ID %q...",
+		n.HumanId(), id)))
+	return terraform.HookActionContinue, nil
+}
+
+func (h *UiHook) PostImportState(
+	n *terraform.InstanceInfo,
+	s []*terraform.InstanceState) (terraform.HookAction, error) {
+	h.once.Do(h.init)
+
+	id := n.HumanId()
+	h.ui.Output(h.Colorize.Color(fmt.Sprintf(
+		"[reset][bold][green]%s: Import complete!", id)))
+	for _, s := range s {
+		h.ui.Output(h.Colorize.Color(fmt.Sprintf(
+			"[reset][green]  Imported %s (ID: %s)",
+			s.Ephemeral.Type, s.ID)))
+	}
+
+	return terraform.HookActionContinue, nil
+}
+
 func (h *UiHook) init() {
 	if h.Colorize == nil {
 		panic("colorize not given")
@@ -0,0 +1,167 @@
+package command
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// ImportCommand is a cli.Command implementation that imports resources
+// into the Terraform state.
+type ImportCommand struct {
+	Meta
+}
+
+func (c *ImportCommand) Run(args []string) int {
+	args = c.Meta.process(args, true)
+
+	var configPath string
+	cmdFlags := c.Meta.flagSet("import")
+	cmdFlags.StringVar(&configPath, "config", "", "path")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
+	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
+	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.DurationVar(&c.Meta.lockTimeout, "lock-timeout", 0, "lock-timeout")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+
+	args = cmdFlags.Args()
+	if len(args) != 2 {
+		c.Ui.Error("The import command expects two arguments.")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	if configPath == "" {
+		var err error
+		configPath, err = os.Getwd()
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error getting pwd: %s", err))
+			return 1
+		}
+	}
+
+	// Build the context based on the arguments given. The configuration
+	// is only used for the provider configuration.
+	ctx, _, err := c.Context(contextOpts{
+		Path:       configPath,
+		StatePath:  c.Meta.statePath,
+		LockReason: "import",
+	})
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer c.Meta.unlockState()
+	if err := ctx.Input(c.InputMode()); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error configuring: %s", err))
+		return 1
+	}
+
+	// Perform the import. Note that as you can see it is possible for this
+	// API to import more than one resource at once. For now, we only allow
+	// one while we stabilize this feature.
+	newState, err := ctx.Import(&terraform.ImportOpts{
+		Targets: []*terraform.ImportTarget{
+			&terraform.ImportTarget{
+				Addr: args[0],
+				ID:   args[1],
+			},
+		},
+	})
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error importing: %s", err))
+
+		// Even if the import failed, persist whatever made it in so
+		// partial imports aren't lost.
+		if newState != nil {
+			if err := c.Meta.PersistState(newState); err != nil {
+				c.Ui.Error(fmt.Sprintf("Error writing state file: %s", err))
+			}
+		}
+
+		return 1
+	}
+
+	// Persist the final state
+	log.Printf("[INFO] Writing state output to: %s", c.Meta.StateOutPath())
+	if err := c.Meta.PersistState(newState); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing state file: %s", err))
+		return 1
+	}
+
+	c.Ui.Output(c.Colorize().Color("[reset][green]\n" + importCommandSuccessMsg))
+
+	return 0
+}
+
+func (c *ImportCommand) Help() string {
+	helpText := `
+Usage: terraform import [options] ADDR ID
+
+  Import existing infrastructure into your Terraform state.
+
+  This will find and import the specified resource into your Terraform
+  state, allowing existing infrastructure to come under Terraform
+  management without having to be initially created by Terraform.
+
+  The ADDR specified is the address to import the resource to. Please
+  see the documentation online for resource addresses. The ID is a
+  resource-specific ID to identify that resource being imported. Please
+  reference the documentation for the resource type you're importing to
+  determine the ID syntax to use. It typically matches directly to the ID
+  that the provider uses.
+
+  Only resources in the root module can be imported for now. The
+  configuration in the current directory (or -config) is loaded so that
+  the provider can be configured; the resource itself doesn't need to
+  exist in the configuration.
+
+Options:
+
+  -backup=path        Path to backup the existing state file before
+                      modifying. Defaults to the "-state-out" path with
+                      ".backup" extension. Set to "-" to disable backup.
+
+  -config=path        Path to a directory of Terraform configuration files
+                      to use to configure the provider. Defaults to pwd.
+
+  -input=true         Ask for input for variables if not directly set.
+
+  -lock-timeout=0s    Duration to retry acquiring the state lock if it
+                      is held by another Terraform run.
+
+  -no-color           If specified, output won't contain any color.
+
+  -state=path         Path to read and save state (unless state-out
+                      is specified). Defaults to "terraform.tfstate".
+
+  -state-out=path     Path to write updated state file. By default, the
+                      "-state" path will be used.
+
+  -var 'foo=bar'      Set a variable in the Terraform configuration. This
+                      flag can be set multiple times.
+
+  -var-file=foo       Set variables in the Terraform configuration from
+                      a file. If "terraform.tfvars" is present, it will be
+                      automatically loaded if this flag is not specified.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *ImportCommand) Synopsis() string {
+	return "Import existing infrastructure into Terraform"
+}
+
+const importCommandSuccessMsg = `Import success! The resources imported are shown above. These are
+now in your Terraform state. Import does not currently generate
+configuration, so you must do this next. If you do not create configuration
+for the above resources, then the next ` + "`terraform plan`" + ` will mark
+them for destruction.
+`
@@ -5,9 +5,13 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"log"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-getter"
 	"github.com/hashicorp/terraform/config/module"
@@ -23,10 +27,15 @@ type Meta struct {
 	ContextOpts *terraform.ContextOpts
 	Ui          cli.Ui
 
+	// ProviderResolver, if set, is used to select the provider plugins
+	// that satisfy the version constraints in the configuration.
+	ProviderResolver *ProviderResolver
+
 	// State read when calling `Context`. This is available after calling
 	// `Context`.
 	state       state.State
 	stateResult *StateResult
+	stateLocked bool
 
 	// This can be set by the command itself to provide extra hooks.
 	extraHooks []terraform.Hook
@@ -63,10 +72,14 @@ type Meta struct {
 	//
 	// parallelism is used to control the number of concurrent operations
 	// allowed when walking the graph
+	//
+	// lockTimeout is how long to keep retrying to acquire the state lock
+	// if it is held elsewhere. Zero means only a single attempt is made.
 	statePath    string
 	stateOutPath string
 	backupPath   string
 	parallelism  int
+	lockTimeout  time.Duration
 }
 
 // initStatePaths is used to initialize the default values for
@@ -109,7 +122,8 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 		f.Close()
 		if err == nil {
 			// Setup our state
-			state, statePath, err := StateFromPlan(m.statePath, plan)
+			state, statePath, err := StateFromPlan(
+				m.localStatePath(), m.remoteStatePath(), plan)
 			if err != nil {
 				return nil, false, fmt.Errorf("Error loading plan: %s", err)
 			}
@@ -126,6 +140,26 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 						"variable values, create a new plan file.")
 			}
 
+			if err := m.resolveProviders(opts, plan.Module); err != nil {
+				return nil, false, err
+			}
+
+			if copts.LockReason != "" {
+				if err := m.lockState(copts.LockReason); err != nil {
+					return nil, false, err
+				}
+			}
+
+			// Make sure the state hasn't changed since the plan was made.
+			// This is done while holding the lock so that nothing can
+			// change the state between the check and the apply.
+			if copts.CheckPlanState {
+				if err := m.checkPlanState(plan); err != nil {
+					m.unlockState()
+					return nil, false, err
+				}
+			}
+
 			return plan.Context(opts), true, nil
 		}
 	}
@@ -155,6 +189,22 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 		return nil, false, fmt.Errorf("Error downloading modules: %s", err)
 	}
 
+	if err := m.resolveProviders(opts, mod); err != nil {
+		return nil, false, err
+	}
+
+	// Lock the state and re-read it so that we're working with the
+	// latest state and nothing else can change it until we're done.
+	if copts.LockReason != "" {
+		if err := m.lockState(copts.LockReason); err != nil {
+			return nil, false, err
+		}
+		if err := state.RefreshState(); err != nil {
+			m.unlockState()
+			return nil, false, fmt.Errorf("Error reloading state: %s", err)
+		}
+	}
+
 	opts.Module = mod
 	opts.Parallelism = copts.Parallelism
 	opts.State = state.State()
@@ -162,6 +212,22 @@ func (m *Meta) Context(copts contextOpts) (*terraform.Context, bool, error) {
 	return ctx, false, nil
 }
 
+// resolveProviders replaces the provider factories in opts with the
+// provider plugins that satisfy the version constraints in mod.
+func (m *Meta) resolveProviders(opts *terraform.ContextOpts, mod *module.Tree) error {
+	if m.ProviderResolver == nil || mod == nil {
+		return nil
+	}
+
+	providers, err := m.ProviderResolver.Resolve(mod, opts.Providers)
+	if err != nil {
+		return fmt.Errorf("Error selecting provider plugins: %s", err)
+	}
+
+	opts.Providers = providers
+	return nil
+}
+
 // DataDir returns the directory where local data will be stored.
 func (m *Meta) DataDir() string {
 	dataDir := DefaultDataDirectory
@@ -234,23 +300,165 @@ func (m *Meta) StateRaw(opts *StateOpts) (*StateResult, error) {
 	return result, nil
 }
 
-// StateOpts returns the default state options
-func (m *Meta) StateOpts() *StateOpts {
-	localPath := m.statePath
-	if localPath == "" {
-		localPath = DefaultStateFilename
+// checkPlanState verifies that the current state is still the state that
+// the given plan was created against, by comparing the serial of the state
+// stored in the plan with the serial of the state as it is now. If the
+// state was modified since, for example by another apply, the plan may no
+// longer be correct. The state is read again rather than taken from the
+// plan so that the latest persisted state is compared.
+func (m *Meta) checkPlanState(plan *terraform.Plan) error {
+	result, err := State(m.StateOpts())
+	if err != nil {
+		return fmt.Errorf("Error loading state: %s", err)
+	}
+
+	var serial int64
+	if result.State != nil {
+		if s := result.State.State(); s != nil {
+			serial = s.Serial
+		}
+	}
+
+	var planSerial int64
+	if plan.State != nil {
+		planSerial = plan.State.Serial
 	}
-	remotePath := filepath.Join(m.DataDir(), DefaultStateFilename)
 
+	if serial != planSerial {
+		return fmt.Errorf(
+			"The state has changed since this plan was created (plan serial: %d,\n"+
+				"current serial: %d). Applying the plan could have unexpected results.\n"+
+				"Create a new plan, or use the -force flag to apply this plan anyway.",
+			planSerial, serial)
+	}
+
+	return nil
+}
+
+// StateOpts returns the default state options
+func (m *Meta) StateOpts() *StateOpts {
 	return &StateOpts{
-		LocalPath:     localPath,
+		LocalPath:     m.localStatePath(),
 		LocalPathOut:  m.stateOutPath,
-		RemotePath:    remotePath,
+		RemotePath:    m.remoteStatePath(),
 		RemoteRefresh: true,
 		BackupPath:    m.backupPath,
 	}
 }
 
+// localStatePath returns the path to the local state file. Unless a
+// path was given with -state, this is the state file of the current
+// environment.
+func (m *Meta) localStatePath() string {
+	if m.statePath != "" {
+		return m.statePath
+	}
+
+	return envStatePath(m.Env())
+}
+
+// remoteStatePath returns the path to the remote state cache of the
+// current environment.
+func (m *Meta) remoteStatePath() string {
+	return m.envRemoteStatePath(m.Env())
+}
+
+// envRemoteStatePath returns the path to the remote state cache of the
+// environment with the given name.
+func (m *Meta) envRemoteStatePath(env string) string {
+	if env == terraform.DefaultEnvironment {
+		return filepath.Join(m.DataDir(), DefaultStateFilename)
+	}
+
+	return filepath.Join(m.DataDir(), DefaultEnvDir, env, DefaultStateFilename)
+}
+
+// Env returns the name of the currently selected environment.
+func (m *Meta) Env() string {
+	data, err := ioutil.ReadFile(filepath.Join(m.DataDir(), DefaultEnvFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[ERR] Error reading selected environment: %s", err)
+		}
+
+		return terraform.DefaultEnvironment
+	}
+
+	env := strings.TrimSpace(string(data))
+	if env == "" {
+		return terraform.DefaultEnvironment
+	}
+
+	return env
+}
+
+// SetEnv selects the environment with the given name.
+func (m *Meta) SetEnv(name string) error {
+	if err := os.MkdirAll(m.DataDir(), 0755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(m.DataDir(), DefaultEnvFile)
+	return ioutil.WriteFile(path, []byte(name+"\n"), 0644)
+}
+
+// envStatePath returns the path to the local state file of the
+// environment with the given name.
+func envStatePath(env string) string {
+	if env == terraform.DefaultEnvironment {
+		return DefaultStateFilename
+	}
+
+	return filepath.Join(DefaultEnvDir, env, DefaultStateFilename)
+}
+
+// lockState locks the state for this meta, if the state supports locking.
+// If the lock is held elsewhere, acquiring it is retried until lockTimeout
+// has elapsed.
+func (m *Meta) lockState(reason string) error {
+	l, ok := m.state.(state.Locker)
+	if !ok {
+		return nil
+	}
+
+	deadline := time.Now().Add(m.lockTimeout)
+	for {
+		err := l.Lock(reason)
+		if err == nil {
+			m.stateLocked = true
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf(
+				"Error acquiring the state lock: %s\n\n"+
+					"Terraform acquires a lock on the state to prevent other\n"+
+					"Terraform runs from modifying it at the same time. Wait for\n"+
+					"the other run to finish, or use the -lock-timeout flag to\n"+
+					"keep retrying for longer.", err)
+		}
+
+		log.Printf("[DEBUG] State is locked, retrying: %s", err)
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// unlockState releases a lock acquired by lockState. Failures are reported
+// to the UI since there is nothing else the caller can do about them.
+func (m *Meta) unlockState() {
+	if !m.stateLocked {
+		return
+	}
+
+	m.stateLocked = false
+	if err := m.state.(state.Locker).Unlock(); err != nil {
+		m.Ui.Error(fmt.Sprintf(
+			"Error releasing the state lock: %s\n\n"+
+				"The lock may need to be removed manually before Terraform\n"+
+				"can be run against this state again.", err))
+	}
+}
+
 // UIInput returns a UIInput object to be used for asking for input.
 func (m *Meta) UIInput() terraform.UIInput {
 	return &UIInput{
@@ -295,6 +503,7 @@ func (m *Meta) contextOpts() *terraform.ContextOpts {
 		vs[k] = v
 	}
 	opts.Variables = vs
+	opts.Environment = m.Env()
 	opts.Targets = m.targets
 	opts.UIInput = m.UIInput()
 
@@ -439,4 +648,13 @@ type contextOpts struct {
 
 	// Number of concurrent operations allowed
 	Parallelism int
+
+	// LockReason, if set, causes the state to be locked before it is
+	// read. The reason is shown to anyone else that tries to lock the
+	// state. The lock must be released with unlockState.
+	LockReason string
+
+	// CheckPlanState, if true, makes loading a plan file fail if the
+	// state has changed since the plan was created.
+	CheckPlanState bool
 }
@@ -268,3 +268,34 @@ func TestMeta_addModuleDepthFlag(t *testing.T) {
 		}
 	}
 }
+
+func TestMetaStateOpts_env(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	m := new(Meta)
+	if err := m.SetEnv("prod"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	opts := m.StateOpts()
+	expected := filepath.Join(DefaultEnvDir, "prod", DefaultStateFilename)
+	if opts.LocalPath != expected {
+		t.Fatalf("bad: %s", opts.LocalPath)
+	}
+	expected = filepath.Join(
+		DefaultDataDir, DefaultEnvDir, "prod", DefaultStateFilename)
+	if opts.RemotePath != expected {
+		t.Fatalf("bad: %s", opts.RemotePath)
+	}
+
+	// An explicit state path is used as is, even if it is the default
+	m.statePath = "foo.tfstate"
+	if opts := m.StateOpts(); opts.LocalPath != "foo.tfstate" {
+		t.Fatalf("bad: %s", opts.LocalPath)
+	}
+	m.statePath = DefaultStateFilename
+	if opts := m.StateOpts(); opts.LocalPath != DefaultStateFilename {
+		t.Fatalf("bad: %s", opts.LocalPath)
+	}
+}
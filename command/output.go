@@ -18,7 +18,7 @@ func (c *OutputCommand) Run(args []string) int {
 
 	var module string
 	cmdFlags := flag.NewFlagSet("output", flag.ContinueOnError)
-	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
 	cmdFlags.StringVar(&module, "module", "", "module")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 
@@ -82,6 +82,9 @@ func (c *OutputCommand) Run(args []string) int {
 
 		for _, k := range ks {
 			v := mod.Outputs[k]
+			if mod.OutputSensitive(k) {
+				v = sensitiveValue
+			}
 			c.Ui.Output(fmt.Sprintf("%s = %s", k, v))
 		}
 		return 0
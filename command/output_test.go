@@ -277,6 +277,60 @@ func TestOutput_noVars(t *testing.T) {
 	}
 }
 
+func TestOutput_sensitive(t *testing.T) {
+	originalState := &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root"},
+				Outputs: map[string]string{
+					"foo":      "bar",
+					"password": "secret",
+				},
+				SensitiveOutputs: []string{"password"},
+			},
+		},
+	}
+
+	statePath := testStateFile(t, originalState)
+
+	ui := new(cli.MockUi)
+	c := &OutputCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	expectedOutput := "foo = bar\npassword = <sensitive>\n"
+	output := ui.OutputWriter.String()
+	if output != expectedOutput {
+		t.Fatalf("Expected output: %#v\ngiven: %#v", expectedOutput, output)
+	}
+
+	// Asking for the output by name shows the value
+	ui = new(cli.MockUi)
+	c.Meta.Ui = ui
+	args = []string{
+		"-state", statePath,
+		"password",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	actual := strings.TrimSpace(ui.OutputWriter.String())
+	if actual != "secret" {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
 func TestOutput_stateDefault(t *testing.T) {
 	originalState := &terraform.State{
 		Modules: []*terraform.ModuleState{
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/mitchellh/cli"
 )
 
 // PlanCommand is a Command implementation that compares a Terraform
@@ -16,7 +17,7 @@ type PlanCommand struct {
 }
 
 func (c *PlanCommand) Run(args []string) int {
-	var destroy, refresh, detailed bool
+	var destroy, refresh, detailed, jsonOutput bool
 	var outPath string
 	var moduleDepth int
 
@@ -29,14 +30,23 @@ func (c *PlanCommand) Run(args []string) int {
 	cmdFlags.StringVar(&outPath, "out", "", "path")
 	cmdFlags.IntVar(
 		&c.Meta.parallelism, "parallelism", DefaultParallelism, "parallelism")
-	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.DurationVar(&c.Meta.lockTimeout, "lock-timeout", 0, "lock-timeout")
 	cmdFlags.BoolVar(&detailed, "detailed-exitcode", false, "detailed-exitcode")
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
 	}
 
+	// With -json, stdout is reserved for the JSON document so everything
+	// that is normally output goes to stderr instead.
+	jsonUi := c.Ui
+	if jsonOutput {
+		c.Meta.Ui = &StderrUi{Ui: c.Meta.Ui}
+	}
+
 	var path string
 	args = cmdFlags.Args()
 	if len(args) > 1 {
@@ -63,11 +73,13 @@ func (c *PlanCommand) Run(args []string) int {
 		Path:        path,
 		StatePath:   c.Meta.statePath,
 		Parallelism: c.Meta.parallelism,
+		LockReason:  "plan",
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
 		return 1
 	}
+	defer c.Meta.unlockState()
 	if !validateContext(ctx, c.Ui) {
 		return 1
 	}
@@ -101,6 +113,10 @@ func (c *PlanCommand) Run(args []string) int {
 	}
 
 	if plan.Diff.Empty() {
+		if jsonOutput {
+			return c.outputJSON(jsonUi, plan)
+		}
+
 		c.Ui.Output(
 			"No changes. Infrastructure is up-to-date. This means that Terraform\n" +
 				"could not detect any differences between your configuration and\n" +
@@ -122,6 +138,16 @@ func (c *PlanCommand) Run(args []string) int {
 		}
 	}
 
+	if jsonOutput {
+		if code := c.outputJSON(jsonUi, plan); code != 0 {
+			return code
+		}
+		if detailed {
+			return 2
+		}
+		return 0
+	}
+
 	if outPath == "" {
 		c.Ui.Output(strings.TrimSpace(planHeaderNoOutput) + "\n")
 	} else {
@@ -149,6 +175,18 @@ func (c *PlanCommand) Run(args []string) int {
 	return 0
 }
 
+// outputJSON writes the plan as JSON to the given Ui.
+func (c *PlanCommand) outputJSON(ui cli.Ui, plan *terraform.Plan) int {
+	out, err := FormatPlanJSON(plan)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error formatting plan as JSON: %s", err))
+		return 1
+	}
+
+	ui.Output(out)
+	return 0
+}
+
 func (c *PlanCommand) Help() string {
 	helpText := `
 Usage: terraform plan [options] [dir]
@@ -177,6 +215,12 @@ Options:
 
   -input=true         Ask for input for variables if not directly set.
 
+  -json               Output the plan as JSON instead of in a human-readable
+                      form. All other output is written to stderr.
+
+  -lock-timeout=0s    Duration to retry acquiring the state lock if it
+                      is held by another Terraform run.
+
   -module-depth=n     Specifies the depth of modules to show in the output.
                       This does not affect the plan itself, only the output
                       shown. By default, this is zero. -1 will expand all.
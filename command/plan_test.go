@@ -1,6 +1,7 @@
 package command
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -176,6 +177,176 @@ func TestPlan_outPath(t *testing.T) {
 	}
 }
 
+func TestPlan_json(t *testing.T) {
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	p.DiffReturn = &terraform.InstanceDiff{
+		Destroy: true,
+	}
+
+	args := []string{
+		"-json",
+		"-detailed-exitcode",
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 2 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	var actual jsonPlan
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+	if len(actual.Changes) != 1 {
+		t.Fatalf("bad: %#v", actual.Changes)
+	}
+	if change := actual.Changes[0]; change.Address != "test_instance.foo" {
+		t.Fatalf("bad: %#v", change)
+	}
+}
+
+func TestPlan_outPathStateSerial(t *testing.T) {
+	tf, err := ioutil.TempFile("", "tf")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	outPath := tf.Name()
+	os.Remove(tf.Name())
+
+	originalState := testState()
+	originalState.Serial = 3
+	statePath := testStateFile(t, originalState)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	p.DiffReturn = &terraform.InstanceDiff{
+		Destroy: true,
+	}
+
+	args := []string{
+		"-refresh=false",
+		"-state", statePath,
+		"-out", outPath,
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	plan := testReadPlan(t, outPath)
+	if plan.State == nil || plan.State.Serial != 3 {
+		t.Fatalf("bad: %#v", plan.State)
+	}
+}
+
+// The state is persisted after the refresh that runs before the plan,
+// which changes its serial. The plan must record that new serial, or
+// applying it would be rejected as if the state had changed since.
+func TestPlan_outPathStateSerialRefresh(t *testing.T) {
+	tf, err := ioutil.TempFile("", "tf")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	outPath := tf.Name()
+	os.Remove(tf.Name())
+
+	originalState := testState()
+	originalState.Serial = 3
+	statePath := testStateFile(t, originalState)
+
+	p := testProvider()
+	ui := new(cli.MockUi)
+	c := &PlanCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	// Refresh finds a change so that the persisted state is different
+	p.RefreshFn = func(
+		info *terraform.InstanceInfo,
+		s *terraform.InstanceState) (*terraform.InstanceState, error) {
+		return &terraform.InstanceState{
+			ID:         s.ID,
+			Attributes: map[string]string{"ami": "baz"},
+		}, nil
+	}
+	p.DiffReturn = &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"ami": &terraform.ResourceAttrDiff{
+				Old: "baz",
+				New: "bar",
+			},
+		},
+	}
+	p.ApplyReturn = &terraform.InstanceState{ID: "bar"}
+
+	args := []string{
+		"-state", statePath,
+		"-out", outPath,
+		testFixturePath("plan"),
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if !p.RefreshCalled {
+		t.Fatal("refresh should be called")
+	}
+
+	f, err := os.Open(statePath)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	persisted, err := terraform.ReadState(f)
+	f.Close()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if persisted.Serial <= 3 {
+		t.Fatalf("refreshed state should have a new serial: %d", persisted.Serial)
+	}
+
+	plan := testReadPlan(t, outPath)
+	if plan.State == nil || plan.State.Serial != persisted.Serial {
+		t.Fatalf("bad: %#v", plan.State)
+	}
+
+	// Applying the saved plan must pass the state serial check
+	ui = new(cli.MockUi)
+	ac := &ApplyCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(p),
+			Ui:          ui,
+		},
+	}
+
+	args = []string{
+		"-state", statePath,
+		outPath,
+	}
+	if code := ac.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+	if !p.ApplyCalled {
+		t.Fatal("apply should be called")
+	}
+}
+
 func TestPlan_refresh(t *testing.T) {
 	p := testProvider()
 	ui := new(cli.MockUi)
@@ -0,0 +1,237 @@
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/config/module"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// PluginVersionSep separates the name of a plugin from its version in
+// the file name of a versioned plugin binary, such as
+// "terraform-provider-aws_v1.2.0".
+const PluginVersionSep = "_v"
+
+// PluginMeta is a single plugin binary found during plugin discovery.
+type PluginMeta struct {
+	// Kind is the kind of plugin, such as "provider" or "provisioner".
+	Kind string
+
+	// Name is the name of the plugin, such as "aws".
+	Name string
+
+	// Version is the version of the plugin as given in its file name.
+	// This is nil if the plugin binary isn't versioned.
+	Version *version.Version
+
+	// Path is the path to the plugin binary.
+	Path string
+}
+
+// ParsePluginPath parses the path to a plugin binary named like
+// "terraform-provider-aws" or "terraform-provider-aws_v1.2.0". An
+// extension such as ".exe" is ignored. It returns nil if the file name
+// isn't a plugin name at all.
+//
+// The version separator only starts a version when it is followed by a
+// digit, so plugin names such as "terraform-provider-my_vsphere" can
+// contain it.
+func ParsePluginPath(path string) (*PluginMeta, error) {
+	file := filepath.Base(path)
+
+	var v *version.Version
+	idx := strings.LastIndex(file, PluginVersionSep)
+	if idx >= 0 {
+		rest := file[idx+len(PluginVersionSep):]
+		if rest == "" || rest[0] < '0' || rest[0] > '9' {
+			idx = -1
+		}
+	}
+
+	if idx >= 0 {
+		raw := strings.TrimSuffix(file[idx+len(PluginVersionSep):], ".exe")
+
+		var err error
+		v, err = version.NewVersion(raw)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Invalid version in plugin file name %s: %s", file, err)
+		}
+
+		file = file[:idx]
+	} else if idx := strings.Index(file, "."); idx >= 0 {
+		file = file[:idx]
+	}
+
+	// Look for foo-bar-baz. The plugin name is "baz"
+	parts := strings.SplitN(file, "-", 3)
+	if len(parts) != 3 {
+		return nil, nil
+	}
+
+	return &PluginMeta{
+		Kind:    parts[1],
+		Name:    parts[2],
+		Version: v,
+		Path:    path,
+	}, nil
+}
+
+// PluginSet is the set of discovered plugin binaries of a single kind,
+// keyed by plugin name.
+type PluginSet map[string][]*PluginMeta
+
+// Add adds a plugin to the set. If a plugin with the same name and
+// version is already in the set, it is replaced so that plugins found
+// later during discovery take priority.
+func (s PluginSet) Add(p *PluginMeta) {
+	for i, existing := range s[p.Name] {
+		if pluginVersionEqual(existing.Version, p.Version) {
+			s[p.Name][i] = p
+			return
+		}
+	}
+
+	s[p.Name] = append(s[p.Name], p)
+}
+
+// Newest returns the newest plugin with the given name whose version
+// satisfies the constraints.
+//
+// If constraints is nil, unversioned plugins are allowed as well but
+// any versioned plugin is preferred over them. Unversioned plugins never
+// satisfy a constraint.
+func (s PluginSet) Newest(name string, constraints version.Constraints) (*PluginMeta, error) {
+	var result *PluginMeta
+	var found []string
+	for _, p := range s[name] {
+		if p.Version == nil {
+			if constraints == nil && (result == nil || result.Version == nil) {
+				result = p
+			}
+			continue
+		}
+
+		found = append(found, p.Version.String())
+		if constraints != nil && !constraints.Check(p.Version) {
+			continue
+		}
+		if result == nil || result.Version == nil ||
+			p.Version.GreaterThan(result.Version) {
+			result = p
+		}
+	}
+
+	if result != nil {
+		return result, nil
+	}
+
+	if len(found) == 0 {
+		return nil, fmt.Errorf(
+			"No versioned plugin found for %q. Versioned plugin binaries\n"+
+				"must be named like \"terraform-provider-%s%s1.2.0\".",
+			name, name, PluginVersionSep)
+	}
+
+	return nil, fmt.Errorf(
+		"No plugin found for %q matching the version constraint %q.\n"+
+			"Available versions: %s",
+		name, constraints.String(), strings.Join(found, ", "))
+}
+
+// ProviderResolver selects the provider plugins to use for a
+// configuration based on the version constraints of its providers.
+type ProviderResolver struct {
+	// Plugins are all the provider plugins found during discovery.
+	Plugins PluginSet
+
+	// Factory returns the factory that starts the given plugin binary.
+	Factory func(*PluginMeta) terraform.ResourceProviderFactory
+}
+
+// Resolve returns the provider factories to use for the given module
+// tree. Providers without a version constraint keep the factory from
+// providers. The rest use the newest plugin that satisfies every
+// constraint given for that provider anywhere in the tree.
+func (r *ProviderResolver) Resolve(
+	mod *module.Tree,
+	providers map[string]terraform.ResourceProviderFactory) (map[string]terraform.ResourceProviderFactory, error) {
+	constraints, err := providerConstraints(mod)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]terraform.ResourceProviderFactory)
+	for k, v := range providers {
+		result[k] = v
+	}
+
+	names := make([]string, 0, len(constraints))
+	for name, _ := range constraints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		p, err := r.Plugins.Newest(name, constraints[name])
+		if err != nil {
+			return nil, fmt.Errorf("provider.%s: %s", name, err)
+		}
+
+		result[name] = r.Factory(p)
+	}
+
+	return result, nil
+}
+
+// providerConstraints returns the version constraints for each provider
+// in the module tree, combining the constraints of all the modules.
+func providerConstraints(mod *module.Tree) (map[string]version.Constraints, error) {
+	result := make(map[string]version.Constraints)
+
+	var walk func(*module.Tree) error
+	walk = func(t *module.Tree) error {
+		if c := t.Config(); c != nil {
+			for _, p := range c.ProviderConfigs {
+				if p.Version == "" {
+					continue
+				}
+
+				cs, err := version.NewConstraint(p.Version)
+				if err != nil {
+					return fmt.Errorf(
+						"provider.%s: invalid version constraint %q: %s",
+						p.FullName(), p.Version, err)
+				}
+
+				result[p.Name] = append(result[p.Name], cs...)
+			}
+		}
+
+		for _, child := range t.Children() {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk(mod); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func pluginVersionEqual(a, b *version.Version) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(b)
+}
@@ -0,0 +1,186 @@
+package command
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParsePluginPath(t *testing.T) {
+	cases := []struct {
+		Path    string
+		Name    string
+		Version string
+		Nil     bool
+		Err     bool
+	}{
+		{"/bin/terraform-provider-aws", "aws", "", false, false},
+		{"/bin/terraform-provider-aws.exe", "aws", "", false, false},
+		{"/bin/terraform-provider-aws_v1.2.0", "aws", "1.2.0", false, false},
+		{"/bin/terraform-provider-aws_v1.2.0.exe", "aws", "1.2.0", false, false},
+		{"/bin/terraform-provider-aws_v1.foo", "", "", false, true},
+		{"/bin/terraform-provider-my_vsphere", "my_vsphere", "", false, false},
+		{"/bin/terraform-provider-my_vsphere_v1.0.0", "my_vsphere", "1.0.0", false, false},
+		{"/bin/terraform-foo", "", "", true, false},
+	}
+
+	for _, tc := range cases {
+		p, err := ParsePluginPath(tc.Path)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%s: err: %s", tc.Path, err)
+		}
+		if tc.Err {
+			continue
+		}
+		if (p == nil) != tc.Nil {
+			t.Fatalf("%s: bad: %#v", tc.Path, p)
+		}
+		if p == nil {
+			continue
+		}
+
+		if p.Kind != "provider" || p.Name != tc.Name || p.Path != tc.Path {
+			t.Fatalf("%s: bad: %#v", tc.Path, p)
+		}
+
+		var v string
+		if p.Version != nil {
+			v = p.Version.String()
+		}
+		if v != tc.Version {
+			t.Fatalf("%s: bad version: %s", tc.Path, v)
+		}
+	}
+}
+
+func TestPluginSetNewest(t *testing.T) {
+	s := testPluginSet(t,
+		"/a/terraform-provider-test",
+		"/a/terraform-provider-test_v1.0.0",
+		"/a/terraform-provider-test_v1.5.0",
+		"/b/terraform-provider-test_v1.5.0",
+		"/a/terraform-provider-test_v2.1.0")
+
+	cases := []struct {
+		Constraint string
+		Path       string
+		Err        bool
+	}{
+		{"", "/a/terraform-provider-test_v2.1.0", false},
+		{"< 2.0", "/b/terraform-provider-test_v1.5.0", false},
+		{"~> 1.0.0", "/a/terraform-provider-test_v1.0.0", false},
+		{"> 3.0", "", true},
+	}
+
+	for _, tc := range cases {
+		var cs version.Constraints
+		if tc.Constraint != "" {
+			var err error
+			cs, err = version.NewConstraint(tc.Constraint)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+		}
+
+		p, err := s.Newest("test", cs)
+		if (err != nil) != tc.Err {
+			t.Fatalf("%q: err: %s", tc.Constraint, err)
+		}
+		if tc.Err {
+			continue
+		}
+		if p.Path != tc.Path {
+			t.Fatalf("%q: bad: %s", tc.Constraint, p.Path)
+		}
+	}
+}
+
+func TestPluginSetNewest_unversioned(t *testing.T) {
+	s := testPluginSet(t,
+		"/a/terraform-provider-test",
+		"/b/terraform-provider-test")
+
+	p, err := s.Newest("test", nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if p.Path != "/b/terraform-provider-test" {
+		t.Fatalf("bad: %s", p.Path)
+	}
+
+	cs, err := version.NewConstraint(">= 1.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := s.Newest("test", cs); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestProviderResolver(t *testing.T) {
+	var selected []string
+	r := &ProviderResolver{
+		Plugins: testPluginSet(t,
+			"/a/terraform-provider-test_v0.9.0",
+			"/a/terraform-provider-test_v1.2.0",
+			"/a/terraform-provider-test_v2.0.0"),
+		Factory: func(p *PluginMeta) terraform.ResourceProviderFactory {
+			selected = append(selected, p.Path)
+			return nil
+		},
+	}
+
+	providers := map[string]terraform.ResourceProviderFactory{
+		"other": func() (terraform.ResourceProvider, error) {
+			return testProvider(), nil
+		},
+	}
+	result, err := r.Resolve(testModule(t, "plugin-version"), providers)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{"/a/terraform-provider-test_v1.2.0"}
+	if !reflect.DeepEqual(selected, expected) {
+		t.Fatalf("bad: %#v", selected)
+	}
+	if _, ok := result["test"]; !ok {
+		t.Fatalf("bad: %#v", result)
+	}
+	if result["other"] == nil {
+		t.Fatalf("bad: %#v", result)
+	}
+}
+
+func TestProviderResolver_unsatisfied(t *testing.T) {
+	r := &ProviderResolver{
+		Plugins: testPluginSet(t,
+			"/a/terraform-provider-test_v0.9.0",
+			"/a/terraform-provider-test_v2.0.0"),
+		Factory: func(p *PluginMeta) terraform.ResourceProviderFactory {
+			t.Fatalf("should not be called: %#v", p)
+			return nil
+		},
+	}
+
+	_, err := r.Resolve(testModule(t, "plugin-version"), nil)
+	if err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func testPluginSet(t *testing.T, paths ...string) PluginSet {
+	s := make(PluginSet)
+	for _, path := range paths {
+		p, err := ParsePluginPath(path)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		s.Add(p)
+	}
+
+	return s
+}
@@ -17,10 +17,11 @@ func (c *RefreshCommand) Run(args []string) int {
 	args = c.Meta.process(args, true)
 
 	cmdFlags := c.Meta.flagSet("refresh")
-	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
 	cmdFlags.IntVar(&c.Meta.parallelism, "parallelism", 0, "parallelism")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.DurationVar(&c.Meta.lockTimeout, "lock-timeout", 0, "lock-timeout")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -53,7 +54,8 @@ func (c *RefreshCommand) Run(args []string) int {
 	// will actually do this, but we want to provide a richer error message
 	// if possible.
 	if !state.State().IsRemote() {
-		if _, err := os.Stat(c.Meta.statePath); err != nil {
+		statePath := c.Meta.localStatePath()
+		if _, err := os.Stat(statePath); err != nil {
 			if os.IsNotExist(err) {
 				c.Ui.Error(fmt.Sprintf(
 					"The Terraform state file for your infrastructure does not\n"+
@@ -63,7 +65,7 @@ func (c *RefreshCommand) Run(args []string) int {
 						"haven't created infrastructure with Terraform yet, use the\n"+
 						"'terraform apply' command.\n\n"+
 						"Path: %s",
-					c.Meta.statePath))
+					statePath))
 				return 1
 			}
 
@@ -71,7 +73,7 @@ func (c *RefreshCommand) Run(args []string) int {
 				"There was an error reading the Terraform state that is needed\n"+
 					"for refreshing. The path and error are shown below.\n\n"+
 					"Path: %s\n\nError: %s",
-				c.Meta.statePath,
+				statePath,
 				err))
 			return 1
 		}
@@ -82,11 +84,13 @@ func (c *RefreshCommand) Run(args []string) int {
 		Path:        configPath,
 		StatePath:   c.Meta.statePath,
 		Parallelism: c.Meta.parallelism,
+		LockReason:  "refresh",
 	})
 	if err != nil {
 		c.Ui.Error(err.Error())
 		return 1
 	}
+	defer c.Meta.unlockState()
 	if !validateContext(ctx, c.Ui) {
 		return 1
 	}
@@ -133,6 +137,9 @@ Options:
 
   -input=true         Ask for input for variables if not directly set.
 
+  -lock-timeout=0s    Duration to retry acquiring the state lock if it
+                      is held by another Terraform run.
+
   -no-color           If specified, output won't contain any color.
 
   -state=path         Path to read and save state (unless state-out
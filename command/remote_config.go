@@ -35,7 +35,7 @@ func (c *RemoteConfigCommand) Run(args []string) int {
 	cmdFlags := flag.NewFlagSet("remote", flag.ContinueOnError)
 	cmdFlags.BoolVar(&c.conf.disableRemote, "disable", false, "")
 	cmdFlags.BoolVar(&c.conf.pullOnDisable, "pull", true, "")
-	cmdFlags.StringVar(&c.conf.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.StringVar(&c.conf.statePath, "state", "", "path")
 	cmdFlags.StringVar(&c.conf.backupPath, "backup", "", "path")
 	cmdFlags.StringVar(&c.remoteConf.Type, "backend", "atlas", "")
 	cmdFlags.Var((*FlagKV)(&config), "backend-config", "config")
@@ -48,8 +48,10 @@ func (c *RemoteConfigCommand) Run(args []string) int {
 	// Lowercase the type
 	c.remoteConf.Type = strings.ToLower(c.remoteConf.Type)
 
-	// Set the local state path
+	// Set the local state path, taking the current environment into
+	// account.
 	c.statePath = c.conf.statePath
+	c.conf.statePath = c.localStatePath()
 
 	// Populate the various configurations
 	c.remoteConf.Config = config
@@ -86,6 +88,22 @@ func (c *RemoteConfigCommand) Run(args []string) int {
 		return c.disableRemoteState()
 	}
 
+	// Ensure no other environment uses the same remote state, since
+	// the environments would overwrite each other's state.
+	env, err := c.remoteConfigEnv(&c.remoteConf)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error checking remote state of environments: %s", err))
+		return 1
+	}
+	if env != "" {
+		c.Ui.Error(fmt.Sprintf(
+			"The environment %q already uses this remote state configuration.\n"+
+				"Each environment needs its own remote state, for example with the\n"+
+				"name of the environment in the key or path of the state. Aborting.",
+			env))
+		return 1
+	}
+
 	// Ensure there is no conflict, and then do the correct operation
 	var result int
 	haveCache := !remoteState.Empty()
@@ -145,6 +163,33 @@ func (c *RemoteConfigCommand) Run(args []string) int {
 	return 0
 }
 
+// remoteConfigEnv returns the name of another environment that already
+// uses the given remote state configuration, or "" if there is none.
+func (c *RemoteConfigCommand) remoteConfigEnv(conf *terraform.RemoteState) (string, error) {
+	current := c.Env()
+	names, err := c.envList()
+	if err != nil {
+		return "", err
+	}
+
+	for _, name := range names {
+		if name == current {
+			continue
+		}
+
+		ls := &state.LocalState{Path: c.envRemoteStatePath(name)}
+		if err := ls.RefreshState(); err != nil {
+			return "", err
+		}
+
+		if s := ls.State(); s != nil && s.Remote != nil && s.Remote.Equals(conf) {
+			return name, nil
+		}
+	}
+
+	return "", nil
+}
+
 // disableRemoteState is used to disable remote state management,
 // and move the state file into place.
 func (c *RemoteConfigCommand) disableRemoteState() int {
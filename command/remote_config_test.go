@@ -269,6 +269,50 @@ func TestRemoteConfig_initBlank(t *testing.T) {
 	}
 }
 
+// Test that the remote state of another environment isn't reused
+func TestRemoteConfig_initBlank_otherEnv(t *testing.T) {
+	tmp, cwd := testCwd(t)
+	defer testFixCwd(t, tmp, cwd)
+
+	args := []string{
+		"-backend=http",
+		"-backend-config", "address=http://example.com",
+		"-pull=false",
+	}
+
+	ui := new(cli.MockUi)
+	c := &RemoteConfigCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	if err := c.SetEnv("prod"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ui = new(cli.MockUi)
+	c = &RemoteConfigCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: \n%s", ui.OutputWriter.String())
+	}
+
+	remotePath := filepath.Join(
+		DefaultDataDir, DefaultEnvDir, "prod", DefaultStateFilename)
+	if _, err := os.Stat(remotePath); err == nil {
+		t.Fatal("remote state of the environment should not exist")
+	}
+}
+
 // Test initializing without remote settings
 func TestRemoteConfig_initBlank_missingRemote(t *testing.T) {
 	tmp, cwd := testCwd(t)
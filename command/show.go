@@ -17,11 +17,13 @@ type ShowCommand struct {
 
 func (c *ShowCommand) Run(args []string) int {
 	var moduleDepth int
+	var jsonOutput bool
 
 	args = c.Meta.process(args, false)
 
 	cmdFlags := flag.NewFlagSet("show", flag.ContinueOnError)
 	c.addModuleDepthFlag(cmdFlags, &moduleDepth)
+	cmdFlags.BoolVar(&jsonOutput, "json", false, "json")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -74,7 +76,7 @@ func (c *ShowCommand) Run(args []string) int {
 			return 1
 		}
 		state = result.State.State()
-		if state == nil {
+		if state == nil && !jsonOutput {
 			c.Ui.Output("No state.")
 			return 0
 		}
@@ -91,6 +93,23 @@ func (c *ShowCommand) Run(args []string) int {
 		return 1
 	}
 
+	if jsonOutput {
+		var out string
+		var err error
+		if plan != nil {
+			out, err = FormatPlanJSON(plan)
+		} else {
+			out, err = FormatStateJSON(state)
+		}
+		if err != nil {
+			c.Ui.Error(fmt.Sprintf("Error formatting output as JSON: %s", err))
+			return 1
+		}
+
+		c.Ui.Output(out)
+		return 0
+	}
+
 	if plan != nil {
 		c.Ui.Output(FormatPlan(&FormatPlanOpts{
 			Plan:        plan,
@@ -117,6 +136,9 @@ Usage: terraform show [options] [path]
 
 Options:
 
+  -json               Output the state or plan as JSON instead of in a
+                      human-readable form.
+
   -module-depth=n     Specifies the depth of modules to show in the output.
                       By default this is zero. -1 will expand all.
 
@@ -1,9 +1,11 @@
 package command
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -183,3 +185,135 @@ func TestShow_state(t *testing.T) {
 		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
 	}
 }
+
+func TestShow_json(t *testing.T) {
+	statePath := testStateFile(t, testState())
+
+	ui := new(cli.MockUi)
+	c := &ShowCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		statePath,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var actual jsonState
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+	if actual.FormatVersion != jsonFormatVersion {
+		t.Fatalf("bad: %#v", actual)
+	}
+	if len(actual.Resources) != 1 {
+		t.Fatalf("bad: %#v", actual.Resources)
+	}
+	if r := actual.Resources[0]; r.Address != "test_instance.foo" || r.ID != "bar" {
+		t.Fatalf("bad: %#v", r)
+	}
+}
+
+func TestShow_jsonSensitive(t *testing.T) {
+	state := testState()
+	state.RootModule().Resources["test_instance.foo"].Primary = &terraform.InstanceState{
+		ID: "bar",
+		Attributes: map[string]string{
+			"ami":      "ami-1234",
+			"password": "secret",
+		},
+		SensitiveAttributes: []string{"password"},
+	}
+	statePath := testStateFile(t, state)
+
+	ui := new(cli.MockUi)
+	c := &ShowCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		statePath,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var actual jsonState
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+	if len(actual.Resources) != 1 {
+		t.Fatalf("bad: %#v", actual.Resources)
+	}
+
+	expected := map[string]string{
+		"ami":      "ami-1234",
+		"password": sensitiveValue,
+	}
+	if r := actual.Resources[0]; !reflect.DeepEqual(r.Attributes, expected) {
+		t.Fatalf("bad: %#v", r.Attributes)
+	}
+}
+
+func TestShow_jsonPlan(t *testing.T) {
+	planPath := testPlanFile(t, &terraform.Plan{
+		Module: new(module.Tree),
+		Diff: &terraform.Diff{
+			Modules: []*terraform.ModuleDiff{
+				&terraform.ModuleDiff{
+					Path: []string{"root"},
+					Resources: map[string]*terraform.InstanceDiff{
+						"test_instance.foo": &terraform.InstanceDiff{
+							Attributes: map[string]*terraform.ResourceAttrDiff{
+								"ami": &terraform.ResourceAttrDiff{
+									New: "bar",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	ui := new(cli.MockUi)
+	c := &ShowCommand{
+		Meta: Meta{
+			ContextOpts: testCtxConfig(testProvider()),
+			Ui:          ui,
+		},
+	}
+
+	args := []string{
+		"-json",
+		planPath,
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: \n%s", ui.ErrorWriter.String())
+	}
+
+	var actual jsonPlan
+	if err := json.Unmarshal(ui.OutputWriter.Bytes(), &actual); err != nil {
+		t.Fatalf("err: %s\n\n%s", err, ui.OutputWriter.String())
+	}
+	if len(actual.Changes) != 1 {
+		t.Fatalf("bad: %#v", actual.Changes)
+	}
+	change := actual.Changes[0]
+	if change.Address != "test_instance.foo" || change.Action != "create" {
+		t.Fatalf("bad: %#v", change)
+	}
+	if attr, ok := change.Attributes["ami"]; !ok || attr.New != "bar" {
+		t.Fatalf("bad: %#v", change.Attributes)
+	}
+}
@@ -3,7 +3,6 @@ package command
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/errwrap"
@@ -167,9 +166,10 @@ func State(opts *StateOpts) (*StateResult, error) {
 	return result, nil
 }
 
-// StateFromPlan gets our state from the plan.
+// StateFromPlan gets our state from the plan. If the plan was created
+// with remote state, the remote state cache is kept at remotePath.
 func StateFromPlan(
-	localPath string, plan *terraform.Plan) (state.State, string, error) {
+	localPath, remotePath string, plan *terraform.Plan) (state.State, string, error) {
 	var result state.State
 	resultPath := localPath
 	if plan != nil && plan.State != nil &&
@@ -178,7 +178,7 @@ func StateFromPlan(
 
 		// It looks like we have a remote state in the plan, so
 		// we have to initialize that.
-		resultPath = filepath.Join(DefaultDataDir, DefaultStateFilename)
+		resultPath = remotePath
 		result, err = remoteState(plan.State, resultPath, false)
 		if err != nil {
 			return nil, "", err
@@ -0,0 +1,215 @@
+package command
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// StateCommand is a Command implementation that dispatches to the
+// subcommands for inspecting and modifying the state.
+type StateCommand struct {
+	Meta
+}
+
+func (c *StateCommand) Run(argsRaw []string) int {
+	// Duplicate the args so we can munge them without affecting
+	// future subcommand invocations which will do the same.
+	args := make([]string, len(argsRaw))
+	copy(args, argsRaw)
+	args = c.Meta.process(args, false)
+
+	if len(args) == 0 {
+		c.Ui.Error(c.Help())
+		return 1
+	}
+
+	switch args[0] {
+	case "list":
+		cmd := &StateListCommand{Meta: c.Meta}
+		return cmd.Run(args[1:])
+	case "mv":
+		cmd := &StateMvCommand{Meta: c.Meta}
+		return cmd.Run(args[1:])
+	case "rm":
+		cmd := &StateRmCommand{Meta: c.Meta}
+		return cmd.Run(args[1:])
+	case "show":
+		cmd := &StateShowCommand{Meta: c.Meta}
+		return cmd.Run(args[1:])
+	default:
+		c.Ui.Error(c.Help())
+		return 1
+	}
+}
+
+func (c *StateCommand) Help() string {
+	helpText := `
+Usage: terraform state <subcommand> [options] [args]
+
+  Advanced state management. Instead of editing the state file by hand,
+  these subcommands can be used to inspect resources in the state and to
+  move or remove them, for example after renaming a resource or moving
+  it into a module.
+
+  All subcommands that modify the state write a backup of the state
+  before changing it.
+
+Available subcommands:
+
+  list        List resources in the state.
+  mv          Move an item in the state.
+  rm          Remove items from the state.
+  show        Show a resource in the state.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateCommand) Synopsis() string {
+	return "Advanced state management"
+}
+
+// stateResource is a single resource in the state that was found by
+// stateFilter.
+type stateResource struct {
+	// Address is the address of the resource. This is nil for data
+	// resources, which can't be addressed yet.
+	Address *terraform.ResourceAddress
+
+	// Key is the key of the resource within the module state.
+	Key string
+
+	Module   *terraform.ModuleState
+	Resource *terraform.ResourceState
+}
+
+func (r *stateResource) String() string {
+	if r.Address != nil {
+		return r.Address.String()
+	}
+
+	return resourceAddressString(r.Module.Path, r.Key)
+}
+
+// stateFilter returns all the resources in the state that match any of
+// the given addresses. If no addresses are given, all resources are
+// returned. The result is sorted in the order the resources appear in
+// the state.
+func stateFilter(s *terraform.State, addrs []string) ([]*stateResource, error) {
+	filters := make([]*terraform.ResourceAddress, len(addrs))
+	for i, a := range addrs {
+		addr, err := terraform.ParseResourceAddress(a)
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = addr
+	}
+
+	var result []*stateResource
+	if s == nil {
+		return result, nil
+	}
+
+	for _, m := range s.Modules {
+		keys := make([]string, 0, len(m.Resources))
+		for k, _ := range m.Resources {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			addr, err := stateKeyAddress(m.Path, k)
+			if err != nil {
+				return nil, err
+			}
+
+			r := &stateResource{
+				Address:  addr,
+				Key:      k,
+				Module:   m,
+				Resource: m.Resources[k],
+			}
+
+			if len(filters) == 0 {
+				result = append(result, r)
+				continue
+			}
+			if addr == nil {
+				continue
+			}
+			for _, f := range filters {
+				if f.Equals(addr) {
+					result = append(result, r)
+					break
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// stateKeyAddress turns the key of a resource within the module state at
+// the given path into a resource address. Data resources have no address
+// and return nil.
+func stateKeyAddress(path []string, key string) (*terraform.ResourceAddress, error) {
+	parts := strings.Split(key, ".")
+	if parts[0] == "data" {
+		return nil, nil
+	}
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid resource key in state: %s", key)
+	}
+
+	index := -1
+	if len(parts) == 3 {
+		var err error
+		index, err = strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource key in state: %s", key)
+		}
+	}
+
+	return &terraform.ResourceAddress{
+		Path:         path[1:],
+		Index:        index,
+		InstanceType: terraform.TypePrimary,
+		Type:         parts[0],
+		Name:         parts[1],
+	}, nil
+}
+
+// resourceAddressString returns the address of the resource with the
+// given key in the module at path, in the format used on the command line.
+func resourceAddressString(path []string, key string) string {
+	if addr, err := stateKeyAddress(path, key); err == nil && addr != nil {
+		return addr.String()
+	}
+
+	var parts []string
+	for _, p := range path[1:] {
+		parts = append(parts, "module", p)
+	}
+	parts = append(parts, key)
+	return strings.Join(parts, ".")
+}
+
+// stateAddressKey returns the key used for the resource with the given
+// address within a module state.
+func stateAddressKey(addr *terraform.ResourceAddress) string {
+	key := fmt.Sprintf("%s.%s", addr.Type, addr.Name)
+	if addr.Index != -1 {
+		key += fmt.Sprintf(".%d", addr.Index)
+	}
+
+	return key
+}
+
+// stateModulePath returns the module state path for a resource address.
+func stateModulePath(addr *terraform.ResourceAddress) []string {
+	return append([]string{"root"}, addr.Path...)
+}
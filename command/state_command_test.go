@@ -0,0 +1,130 @@
+package command
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestStateFilter(t *testing.T) {
+	cases := map[string]struct {
+		Filters  []string
+		Expected []string
+	}{
+		"all": {
+			nil,
+			[]string{
+				"data.test_data_source.baz",
+				"test_instance.bar[0]",
+				"test_instance.bar[1]",
+				"test_instance.foo",
+				"module.child.test_instance.foo",
+			},
+		},
+
+		"resource": {
+			[]string{"test_instance.foo"},
+			[]string{"test_instance.foo"},
+		},
+
+		"resource with count": {
+			[]string{"test_instance.bar"},
+			[]string{"test_instance.bar[0]", "test_instance.bar[1]"},
+		},
+
+		"index": {
+			[]string{"test_instance.bar[1]"},
+			[]string{"test_instance.bar[1]"},
+		},
+
+		"module": {
+			[]string{"module.child"},
+			[]string{"module.child.test_instance.foo"},
+		},
+
+		"multiple": {
+			[]string{"test_instance.foo", "module.child"},
+			[]string{"test_instance.foo", "module.child.test_instance.foo"},
+		},
+
+		"no match": {
+			[]string{"test_instance.nope"},
+			nil,
+		},
+	}
+
+	for n, tc := range cases {
+		results, err := stateFilter(testStateFilterState(), tc.Filters)
+		if err != nil {
+			t.Fatalf("%s: err: %s", n, err)
+		}
+
+		var actual []string
+		for _, r := range results {
+			actual = append(actual, r.String())
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%s: bad: %#v", n, actual)
+		}
+	}
+}
+
+func TestStateFilter_badAddress(t *testing.T) {
+	if _, err := stateFilter(testStateFilterState(), []string{"foo[bar]"}); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+// testStateFilterState returns a state with a mix of resources for
+// testing the state subcommands.
+func testStateFilterState() *terraform.State {
+	return &terraform.State{
+		Modules: []*terraform.ModuleState{
+			&terraform.ModuleState{
+				Path: []string{"root"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.foo": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "foo",
+							Attributes: map[string]string{
+								"id":  "foo",
+								"ami": "ami-1234",
+							},
+						},
+					},
+					"test_instance.bar.0": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "bar0",
+						},
+					},
+					"test_instance.bar.1": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "bar1",
+						},
+					},
+					"data.test_data_source.baz": &terraform.ResourceState{
+						Type: "test_data_source",
+						Primary: &terraform.InstanceState{
+							ID: "baz",
+						},
+					},
+				},
+			},
+			&terraform.ModuleState{
+				Path: []string{"root", "child"},
+				Resources: map[string]*terraform.ResourceState{
+					"test_instance.foo": &terraform.ResourceState{
+						Type: "test_instance",
+						Primary: &terraform.InstanceState{
+							ID: "child",
+						},
+					},
+				},
+			},
+		},
+	}
+}
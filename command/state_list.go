@@ -0,0 +1,68 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StateListCommand is a Command implementation that lists the resources
+// within a state file.
+type StateListCommand struct {
+	Meta
+}
+
+func (c *StateListCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("state list")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	args = cmdFlags.Args()
+
+	state, err := c.State()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to load state: %s", err))
+		return 1
+	}
+
+	results, err := stateFilter(state.State(), args)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error filtering state: %s", err))
+		return 1
+	}
+
+	for _, r := range results {
+		c.Ui.Output(r.String())
+	}
+
+	return 0
+}
+
+func (c *StateListCommand) Help() string {
+	helpText := `
+Usage: terraform state list [options] [address...]
+
+  List resources in the Terraform state.
+
+  This command lists resources in the Terraform state. The address arguments
+  can be used to filter the resources by resource or module. If no address
+  is given, all resources are listed.
+
+  An address can be a module, such as "module.consul", a resource, such as
+  "aws_instance.web", or a single instance of a resource with a count,
+  such as "aws_instance.web[1]".
+
+Options:
+
+  -state=path         Path to the state file. Defaults to "terraform.tfstate".
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateListCommand) Synopsis() string {
+	return "List resources in the state"
+}
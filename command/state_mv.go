@@ -0,0 +1,253 @@
+package command
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// StateMvCommand is a Command implementation that moves resources and
+// modules to a different address within the state.
+type StateMvCommand struct {
+	Meta
+}
+
+func (c *StateMvCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("state mv")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
+	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
+	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.DurationVar(&c.Meta.lockTimeout, "lock-timeout", 0, "lock-timeout")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	args = cmdFlags.Args()
+	if len(args) != 2 {
+		c.Ui.Error("The state mv command expects exactly two arguments.")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	src, err := terraform.ParseResourceAddress(args[0])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid source address: %s", err))
+		return 1
+	}
+	dst, err := terraform.ParseResourceAddress(args[1])
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Invalid destination address: %s", err))
+		return 1
+	}
+	if src.InstanceType != terraform.TypePrimary ||
+		dst.InstanceType != terraform.TypePrimary {
+		c.Ui.Error("Only primary resources can be moved.")
+		return 1
+	}
+
+	state, err := c.State()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to load state: %s", err))
+		return 1
+	}
+	if err := c.lockState("state mv"); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer c.unlockState()
+	if err := state.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reloading state: %s", err))
+		return 1
+	}
+
+	s := state.State()
+	if s.Empty() {
+		c.Ui.Error(fmt.Sprintf(
+			"The state is empty. The most common reason for this is that\n" +
+				"an invalid state file path was given or Terraform has never\n" +
+				"been run for this infrastructure."))
+		return 1
+	}
+
+	var moved []string
+	if src.Type == "" && src.Name == "" {
+		moved, err = stateMvModule(s, src, dst)
+	} else {
+		moved, err = stateMvResource(s, src, dst)
+	}
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	log.Printf("[INFO] Writing state output to: %s", c.Meta.StateOutPath())
+	if err := c.Meta.PersistState(s); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing state file: %s", err))
+		return 1
+	}
+
+	for _, m := range moved {
+		c.Ui.Output(m)
+	}
+	return 0
+}
+
+// stateMvModule moves the module at src, along with all of its child
+// modules, to dst.
+func stateMvModule(
+	s *terraform.State, src, dst *terraform.ResourceAddress) ([]string, error) {
+	if dst.Type != "" || dst.Name != "" || dst.Index != -1 {
+		return nil, fmt.Errorf(
+			"A module can only be moved to a module address, not %s", dst)
+	}
+	if len(src.Path) == 0 || len(dst.Path) == 0 {
+		return nil, fmt.Errorf("The root module can't be moved.")
+	}
+
+	srcPath := stateModulePath(src)
+	dstPath := stateModulePath(dst)
+	if s.ModuleByPath(srcPath) == nil {
+		return nil, fmt.Errorf("No module found in the state at %s", src)
+	}
+	if s.ModuleByPath(dstPath) != nil {
+		return nil, fmt.Errorf("A module already exists in the state at %s", dst)
+	}
+	if len(dstPath) > len(srcPath) &&
+		reflect.DeepEqual(dstPath[:len(srcPath)], srcPath) {
+		return nil, fmt.Errorf("A module can't be moved into itself.")
+	}
+
+	var moved []string
+	for _, m := range s.Modules {
+		if len(m.Path) < len(srcPath) ||
+			!reflect.DeepEqual(m.Path[:len(srcPath)], srcPath) {
+			continue
+		}
+
+		path := make([]string, 0, len(dstPath)+len(m.Path)-len(srcPath))
+		path = append(path, dstPath...)
+		path = append(path, m.Path[len(srcPath):]...)
+
+		moved = append(moved, fmt.Sprintf(
+			"Moved module.%s to module.%s",
+			strings.Join(m.Path[1:], ".module."),
+			strings.Join(path[1:], ".module.")))
+		m.Path = path
+	}
+
+	return moved, nil
+}
+
+// stateMvResource moves the resources matching src to dst. If src
+// matches every instance of a resource with a count, each instance keeps
+// its index.
+func stateMvResource(
+	s *terraform.State, src, dst *terraform.ResourceAddress) ([]string, error) {
+	if dst.Type == "" || dst.Name == "" {
+		return nil, fmt.Errorf(
+			"A resource can only be moved to a resource address, not %s", dst)
+	}
+
+	results, err := stateFilter(s, []string{src.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("No resource found in the state matching %s", src)
+	}
+	if len(results) > 1 && dst.Index != -1 {
+		return nil, fmt.Errorf(
+			"%s matches %d resources, so the destination can't have an index.",
+			src, len(results))
+	}
+
+	// Compute all the destinations first so we can verify that none of
+	// them exist yet before changing anything.
+	dsts := make([]*terraform.ResourceAddress, len(results))
+	for i, r := range results {
+		if r.Address.Type != dst.Type {
+			return nil, fmt.Errorf(
+				"Can't move %s to %s: the resource type can't be changed.",
+				r, dst)
+		}
+
+		addr := *dst
+		if src.Index == -1 && dst.Index == -1 {
+			addr.Index = r.Address.Index
+		}
+
+		if m := s.ModuleByPath(stateModulePath(&addr)); m != nil {
+			if _, ok := m.Resources[stateAddressKey(&addr)]; ok {
+				return nil, fmt.Errorf(
+					"Can't move %s to %s: a resource already exists there.",
+					r, &addr)
+			}
+		}
+
+		dsts[i] = &addr
+	}
+
+	moved := make([]string, len(results))
+	for i, r := range results {
+		addr := dsts[i]
+		m := s.ModuleByPath(stateModulePath(addr))
+		if m == nil {
+			m = s.AddModule(stateModulePath(addr))
+		}
+
+		delete(r.Module.Resources, r.Key)
+		m.Resources[stateAddressKey(addr)] = r.Resource
+		moved[i] = fmt.Sprintf("Moved %s to %s", r, addr)
+	}
+
+	return moved, nil
+}
+
+func (c *StateMvCommand) Help() string {
+	helpText := `
+Usage: terraform state mv [options] SOURCE DESTINATION
+
+  Move an item in the Terraform state to a different address.
+
+  This command can rename a resource, move a resource into or out of a
+  module, or move an entire module. Nothing is changed in the real
+  infrastructure; only the state is updated so that Terraform knows the
+  resource by its new address. A backup of the state is written before
+  it is modified.
+
+  If SOURCE refers to all instances of a resource with a count, each
+  instance keeps its index at the destination.
+
+  Examples:
+
+    terraform state mv aws_instance.web aws_instance.app
+    terraform state mv aws_instance.web module.app.aws_instance.web
+    terraform state mv module.app module.web
+
+Options:
+
+  -backup=path        Path to backup the existing state file before
+                      modifying. Defaults to the "-state-out" path with
+                      ".backup" extension. Set to "-" to disable backup.
+
+  -lock-timeout=0s    Duration to retry acquiring the state lock if it
+                      is held by another Terraform run.
+
+  -state=path         Path to read and save state (unless state-out
+                      is specified). Defaults to "terraform.tfstate".
+
+  -state-out=path     Path to write updated state file. By default, the
+                      "-state" path will be used.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateMvCommand) Synopsis() string {
+	return "Move an item in the state"
+}
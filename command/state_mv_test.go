@@ -0,0 +1,169 @@
+package command
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestStateMv(t *testing.T) {
+	statePath := testStateFile(t, testStateFilterState())
+
+	ui := new(cli.MockUi)
+	c := &StateMvCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.foo",
+		"test_instance.baz",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	testStateOutput(t, statePath, testStateMvOutput)
+
+	// Test that a backup was written
+	if _, err := os.Stat(statePath + DefaultBackupExtension); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestStateMv_count(t *testing.T) {
+	statePath := testStateFile(t, testStateFilterState())
+
+	ui := new(cli.MockUi)
+	c := &StateMvCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.bar",
+		"module.child.test_instance.bar",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	testStateOutput(t, statePath, testStateMvCountOutput)
+}
+
+func TestStateMv_module(t *testing.T) {
+	statePath := testStateFile(t, testStateFilterState())
+
+	ui := new(cli.MockUi)
+	c := &StateMvCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"module.child",
+		"module.other",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	testStateOutput(t, statePath, testStateMvModuleOutput)
+}
+
+func TestStateMv_exists(t *testing.T) {
+	statePath := testStateFile(t, testStateFilterState())
+
+	ui := new(cli.MockUi)
+	c := &StateMvCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.foo",
+		"test_instance.bar[0]",
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+
+	testStateOutput(t, statePath, testStateFilterState().String())
+}
+
+func TestStateMv_typeChange(t *testing.T) {
+	statePath := testStateFile(t, testStateFilterState())
+
+	ui := new(cli.MockUi)
+	c := &StateMvCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.foo",
+		"other_instance.foo",
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+}
+
+const testStateMvOutput = `
+data.test_data_source.baz:
+  ID = baz
+test_instance.bar.0:
+  ID = bar0
+test_instance.bar.1:
+  ID = bar1
+test_instance.baz:
+  ID = foo
+  ami = ami-1234
+
+module.child:
+  test_instance.foo:
+    ID = child
+`
+
+const testStateMvCountOutput = `
+data.test_data_source.baz:
+  ID = baz
+test_instance.foo:
+  ID = foo
+  ami = ami-1234
+
+module.child:
+  test_instance.bar.0:
+    ID = bar0
+  test_instance.bar.1:
+    ID = bar1
+  test_instance.foo:
+    ID = child
+`
+
+const testStateMvModuleOutput = `
+data.test_data_source.baz:
+  ID = baz
+test_instance.bar.0:
+  ID = bar0
+test_instance.bar.1:
+  ID = bar1
+test_instance.foo:
+  ID = foo
+  ami = ami-1234
+
+module.other:
+  test_instance.foo:
+    ID = child
+`
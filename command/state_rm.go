@@ -0,0 +1,114 @@
+package command
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// StateRmCommand is a Command implementation that removes resources
+// from the state.
+type StateRmCommand struct {
+	Meta
+}
+
+func (c *StateRmCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("state rm")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
+	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
+	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.DurationVar(&c.Meta.lockTimeout, "lock-timeout", 0, "lock-timeout")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	args = cmdFlags.Args()
+	if len(args) == 0 {
+		c.Ui.Error("At least one address is required.")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	state, err := c.State()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to load state: %s", err))
+		return 1
+	}
+	if err := c.lockState("state rm"); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer c.unlockState()
+	if err := state.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reloading state: %s", err))
+		return 1
+	}
+
+	s := state.State()
+	results, err := stateFilter(s, args)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error filtering state: %s", err))
+		return 1
+	}
+	if len(results) == 0 {
+		c.Ui.Error(fmt.Sprintf(
+			"No resources found in the state matching: %s",
+			strings.Join(args, ", ")))
+		return 1
+	}
+
+	for _, r := range results {
+		delete(r.Module.Resources, r.Key)
+	}
+
+	log.Printf("[INFO] Writing state output to: %s", c.Meta.StateOutPath())
+	if err := c.Meta.PersistState(s); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error writing state file: %s", err))
+		return 1
+	}
+
+	for _, r := range results {
+		c.Ui.Output(fmt.Sprintf("Removed %s", r))
+	}
+	c.Ui.Output(fmt.Sprintf("Removed %d resource(s) from the state.", len(results)))
+	return 0
+}
+
+func (c *StateRmCommand) Help() string {
+	helpText := `
+Usage: terraform state rm [options] ADDRESS...
+
+  Remove one or more resources from the Terraform state.
+
+  This command removes resources from the state without destroying them.
+  Terraform will no longer manage the removed resources, and will create
+  them again on the next apply if they are still in the configuration.
+
+  Addresses can refer to a module, a resource, or a single instance of a
+  resource with a count. All resources matching any of the addresses
+  are removed. A backup of the state is written before it is modified.
+
+Options:
+
+  -backup=path        Path to backup the existing state file before
+                      modifying. Defaults to the "-state-out" path with
+                      ".backup" extension. Set to "-" to disable backup.
+
+  -lock-timeout=0s    Duration to retry acquiring the state lock if it
+                      is held by another Terraform run.
+
+  -state=path         Path to read and save state (unless state-out
+                      is specified). Defaults to "terraform.tfstate".
+
+  -state-out=path     Path to write updated state file. By default, the
+                      "-state" path will be used.
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateRmCommand) Synopsis() string {
+	return "Remove resources from the state"
+}
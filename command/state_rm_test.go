@@ -0,0 +1,65 @@
+package command
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mitchellh/cli"
+)
+
+func TestStateRm(t *testing.T) {
+	statePath := testStateFile(t, testStateFilterState())
+
+	ui := new(cli.MockUi)
+	c := &StateRmCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.bar",
+		"module.child",
+	}
+	if code := c.Run(args); code != 0 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.ErrorWriter.String())
+	}
+
+	testStateOutput(t, statePath, testStateRmOutput)
+
+	// Test that a backup was written
+	if _, err := os.Stat(statePath + DefaultBackupExtension); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestStateRm_noMatch(t *testing.T) {
+	statePath := testStateFile(t, testStateFilterState())
+
+	ui := new(cli.MockUi)
+	c := &StateRmCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.nope",
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+}
+
+const testStateRmOutput = `
+data.test_data_source.baz:
+  ID = baz
+test_instance.foo:
+  ID = foo
+  ami = ami-1234
+
+module.child:
+  <no state>
+`
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// StateShowCommand is a Command implementation that shows a single
+// resource from the state.
+type StateShowCommand struct {
+	Meta
+}
+
+func (c *StateShowCommand) Run(args []string) int {
+	args = c.Meta.process(args, false)
+
+	cmdFlags := c.Meta.flagSet("state show")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
+	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
+	if err := cmdFlags.Parse(args); err != nil {
+		return 1
+	}
+	args = cmdFlags.Args()
+	if len(args) != 1 {
+		c.Ui.Error("The state show command expects exactly one argument.")
+		cmdFlags.Usage()
+		return 1
+	}
+
+	state, err := c.State()
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Failed to load state: %s", err))
+		return 1
+	}
+
+	results, err := stateFilter(state.State(), args)
+	if err != nil {
+		c.Ui.Error(fmt.Sprintf("Error filtering state: %s", err))
+		return 1
+	}
+
+	switch len(results) {
+	case 0:
+		c.Ui.Error(fmt.Sprintf("No resource found in the state matching %s", args[0]))
+		return 1
+	case 1:
+	default:
+		c.Ui.Error(fmt.Sprintf(
+			"The address %s matches %d resources. Please give the address\n"+
+				"of a single resource. Use \"terraform state list\" to see\n"+
+				"all matching resources.",
+			args[0], len(results)))
+		return 1
+	}
+
+	r := results[0]
+	is := r.Resource.Primary
+	if is == nil {
+		c.Ui.Output(fmt.Sprintf("%s has no primary instance.", r))
+		return 0
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("id = %s\n", is.ID))
+
+	keys := make([]string, 0, len(is.Attributes))
+	for k, _ := range is.Attributes {
+		if k == "id" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := is.Attributes[k]
+		if is.AttributeSensitive(k) {
+			v = sensitiveValue
+		}
+
+		buf.WriteString(fmt.Sprintf("%s = %s\n", k, v))
+	}
+
+	c.Ui.Output(strings.TrimSpace(buf.String()))
+	return 0
+}
+
+func (c *StateShowCommand) Help() string {
+	helpText := `
+Usage: terraform state show [options] ADDRESS
+
+  Shows the attributes of a single resource in the Terraform state.
+
+  The address must match exactly one resource. For a resource with a
+  count, give the index of the instance, such as "aws_instance.web[1]".
+
+Options:
+
+  -state=path         Path to the state file. Defaults to "terraform.tfstate".
+
+`
+	return strings.TrimSpace(helpText)
+}
+
+func (c *StateShowCommand) Synopsis() string {
+	return "Show a resource in the state"
+}
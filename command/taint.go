@@ -20,9 +20,10 @@ func (c *TaintCommand) Run(args []string) int {
 	cmdFlags := c.Meta.flagSet("taint")
 	cmdFlags.BoolVar(&allowMissing, "allow-missing", false, "module")
 	cmdFlags.StringVar(&module, "module", "", "module")
-	cmdFlags.StringVar(&c.Meta.statePath, "state", DefaultStateFilename, "path")
+	cmdFlags.StringVar(&c.Meta.statePath, "state", "", "path")
 	cmdFlags.StringVar(&c.Meta.stateOutPath, "state-out", "", "path")
 	cmdFlags.StringVar(&c.Meta.backupPath, "backup", "", "path")
+	cmdFlags.DurationVar(&c.Meta.lockTimeout, "lock-timeout", 0, "lock-timeout")
 	cmdFlags.Usage = func() { c.Ui.Error(c.Help()) }
 	if err := cmdFlags.Parse(args); err != nil {
 		return 1
@@ -50,6 +51,17 @@ func (c *TaintCommand) Run(args []string) int {
 		return 1
 	}
 
+	// Lock the state and re-read it so we modify the latest version
+	if err := c.lockState("taint"); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+	defer c.unlockState()
+	if err := state.RefreshState(); err != nil {
+		c.Ui.Error(fmt.Sprintf("Error reloading state: %s", err))
+		return 1
+	}
+
 	// Get the actual state structure
 	s := state.State()
 	if s.Empty() {
@@ -142,6 +154,9 @@ Options:
                       modifying. Defaults to the "-state-out" path with
                       ".backup" extension. Set to "-" to disable backup.
 
+  -lock-timeout=0s    Duration to retry acquiring the state lock if it
+                      is held by another Terraform run.
+
   -module=path        The module path where the resource lives. By
                       default this will be root. Child modules can be specified
                       by names. Ex. "consul" or "consul.vpc" (nested modules).
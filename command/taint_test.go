@@ -2,8 +2,10 @@ package command
 
 import (
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/state"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/mitchellh/cli"
 )
@@ -366,3 +368,32 @@ module.child:
     ID = <not created>
     Tainted ID 1 = blah
 `
+
+func TestTaint_locked(t *testing.T) {
+	statePath := testStateFile(t, testState())
+
+	// Hold the lock as if another Terraform run were modifying the state
+	ls := &state.LocalState{Path: statePath}
+	if err := ls.Lock("test"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer ls.Unlock()
+
+	ui := new(cli.MockUi)
+	c := &TaintCommand{
+		Meta: Meta{
+			Ui: ui,
+		},
+	}
+
+	args := []string{
+		"-state", statePath,
+		"test_instance.foo",
+	}
+	if code := c.Run(args); code != 1 {
+		t.Fatalf("bad: %d\n\n%s", code, ui.OutputWriter.String())
+	}
+	if !strings.Contains(ui.ErrorWriter.String(), "state lock") {
+		t.Fatalf("bad: %s", ui.ErrorWriter.String())
+	}
+}
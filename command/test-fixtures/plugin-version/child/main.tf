@@ -0,0 +1,3 @@
+provider "test" {
+    version = "< 2.0"
+}
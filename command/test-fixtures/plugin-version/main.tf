@@ -0,0 +1,7 @@
+provider "test" {
+    version = ">= 1.0"
+}
+
+module "child" {
+    source = "./child"
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/terraform/command"
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/kardianos/osext"
@@ -25,6 +26,11 @@ type Config struct {
 
 	DisableCheckpoint          bool `hcl:"disable_checkpoint"`
 	DisableCheckpointSignature bool `hcl:"disable_checkpoint_signature"`
+
+	// providerPlugins are all the provider plugin binaries found by
+	// Discover, including every version of each. These are used to
+	// satisfy the version constraints of providers in the configuration.
+	providerPlugins command.PluginSet
 }
 
 // BuiltinConfig is the built-in defaults for the configuration. These
@@ -34,6 +40,10 @@ var BuiltinConfig Config
 // ContextOpts are the global ContextOpts we use to initialize the CLI.
 var ContextOpts terraform.ContextOpts
 
+// ProviderResolver is the global ProviderResolver that commands use to
+// select versioned provider plugins.
+var ProviderResolver command.ProviderResolver
+
 // ConfigFile returns the default path to the configuration file.
 //
 // On Unix-like systems this is the ".terraformrc" file in the home directory.
@@ -76,10 +86,14 @@ func LoadConfig(path string) (*Config, error) {
 // Discover discovers plugins.
 //
 // This looks in the directory of the executable and the CWD, in that
-// order for priority.
+// order for priority. If multiple versions of a plugin are found, the
+// newest is used unless the configuration constrains the version.
 func (c *Config) Discover() error {
+	providers := make(command.PluginSet)
+	provisioners := make(command.PluginSet)
+
 	// Look in the cwd.
-	if err := c.discover("."); err != nil {
+	if err := c.discover(".", providers, provisioners); err != nil {
 		return err
 	}
 
@@ -89,7 +103,8 @@ func (c *Config) Discover() error {
 	if err != nil {
 		log.Printf("[ERR] Error loading config directory: %s", err)
 	} else {
-		if err := c.discover(filepath.Join(dir, "plugins")); err != nil {
+		err := c.discover(filepath.Join(dir, "plugins"), providers, provisioners)
+		if err != nil {
 			return err
 		}
 	}
@@ -100,11 +115,20 @@ func (c *Config) Discover() error {
 	if err != nil {
 		log.Printf("[ERR] Error loading exe directory: %s", err)
 	} else {
-		if err := c.discover(filepath.Dir(exePath)); err != nil {
+		err := c.discover(filepath.Dir(exePath), providers, provisioners)
+		if err != nil {
 			return err
 		}
 	}
 
+	if err := discoverNewest(providers, &c.Providers); err != nil {
+		return err
+	}
+	if err := discoverNewest(provisioners, &c.Provisioners); err != nil {
+		return err
+	}
+
+	c.providerPlugins = providers
 	return nil
 }
 
@@ -127,10 +151,15 @@ func (c1 *Config) Merge(c2 *Config) *Config {
 		result.Provisioners[k] = v
 	}
 
+	result.providerPlugins = c1.providerPlugins
+	if c2.providerPlugins != nil {
+		result.providerPlugins = c2.providerPlugins
+	}
+
 	return &result
 }
 
-func (c *Config) discover(path string) error {
+func (c *Config) discover(path string, providers, provisioners command.PluginSet) error {
 	var err error
 
 	if !filepath.IsAbs(path) {
@@ -141,13 +170,13 @@ func (c *Config) discover(path string) error {
 	}
 
 	err = c.discoverSingle(
-		filepath.Join(path, "terraform-provider-*"), &c.Providers)
+		filepath.Join(path, "terraform-provider-*"), providers)
 	if err != nil {
 		return err
 	}
 
 	err = c.discoverSingle(
-		filepath.Join(path, "terraform-provisioner-*"), &c.Provisioners)
+		filepath.Join(path, "terraform-provisioner-*"), provisioners)
 	if err != nil {
 		return err
 	}
@@ -155,32 +184,43 @@ func (c *Config) discover(path string) error {
 	return nil
 }
 
-func (c *Config) discoverSingle(glob string, m *map[string]string) error {
+func (c *Config) discoverSingle(glob string, s command.PluginSet) error {
 	matches, err := filepath.Glob(glob)
 	if err != nil {
 		return err
 	}
 
-	if *m == nil {
-		*m = make(map[string]string)
+	for _, match := range matches {
+		p, err := command.ParsePluginPath(match)
+		if err != nil {
+			log.Printf("[WARN] Ignoring plugin: %s", err)
+			continue
+		}
+		if p == nil {
+			continue
+		}
+
+		log.Printf("[DEBUG] Discovered plugin: %s = %s", p.Name, match)
+		s.Add(p)
 	}
 
-	for _, match := range matches {
-		file := filepath.Base(match)
+	return nil
+}
 
-		// If the filename has a ".", trim up to there
-		if idx := strings.Index(file, "."); idx >= 0 {
-			file = file[:idx]
-		}
+// discoverNewest adds the newest version of each discovered plugin in s
+// to the map of plugin paths.
+func discoverNewest(s command.PluginSet, m *map[string]string) error {
+	if *m == nil {
+		*m = make(map[string]string)
+	}
 
-		// Look for foo-bar-baz. The plugin name is "baz"
-		parts := strings.SplitN(file, "-", 3)
-		if len(parts) != 3 {
-			continue
+	for name, _ := range s {
+		p, err := s.Newest(name, nil)
+		if err != nil {
+			return err
 		}
 
-		log.Printf("[DEBUG] Discovered plugin: %s = %s", parts[2], match)
-		(*m)[parts[2]] = match
+		(*m)[name] = p.Path
 	}
 
 	return nil
@@ -192,17 +232,31 @@ func (c *Config) discoverSingle(glob string, m *map[string]string) error {
 func (c *Config) ProviderFactories() map[string]terraform.ResourceProviderFactory {
 	result := make(map[string]terraform.ResourceProviderFactory)
 	for k, v := range c.Providers {
-		result[k] = c.providerFactory(v)
+		result[k] = c.providerFactory(v, "")
 	}
 
 	return result
 }
 
-func (c *Config) providerFactory(path string) terraform.ResourceProviderFactory {
+// ProviderResolver returns the ProviderResolver that selects between
+// the versions of the discovered provider plugins.
+func (c *Config) ProviderResolver() command.ProviderResolver {
+	return command.ProviderResolver{
+		Plugins: c.providerPlugins,
+		Factory: func(p *command.PluginMeta) terraform.ResourceProviderFactory {
+			return c.providerFactory(p.Path, p.Version.String())
+		},
+	}
+}
+
+// providerFactory returns the factory for the provider plugin at path.
+// If version is set, the plugin must report that version when it starts.
+func (c *Config) providerFactory(path, version string) terraform.ResourceProviderFactory {
 	// Build the plugin client configuration and init the plugin
 	var config plugin.ClientConfig
 	config.Cmd = pluginCmd(path)
 	config.Managed = true
+	config.Version = version
 	client := plugin.NewClient(&config)
 
 	return func() (terraform.ResourceProvider, error) {
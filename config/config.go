@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform/config/lang"
 	"github.com/hashicorp/terraform/config/lang/ast"
 	"github.com/hashicorp/terraform/flatmap"
@@ -64,6 +65,7 @@ type Module struct {
 type ProviderConfig struct {
 	Name      string
 	Alias     string
+	Version   string
 	RawConfig *RawConfig
 }
 
@@ -71,6 +73,7 @@ type ProviderConfig struct {
 // A Terraform resource is something that represents some component that
 // can be created and managed, and has some properties associated with it.
 type Resource struct {
+	Mode         ResourceMode // which operations the resource supports
 	Name         string
 	Type         string
 	RawCount     *RawConfig
@@ -107,6 +110,7 @@ type Variable struct {
 // resulting data that is highlighted by Terraform when finished.
 type Output struct {
 	Name      string
+	Sensitive bool
 	RawConfig *RawConfig
 }
 
@@ -152,7 +156,14 @@ func (r *Resource) Count() (int, error) {
 
 // A unique identifier for this resource.
 func (r *Resource) Id() string {
-	return fmt.Sprintf("%s.%s", r.Type, r.Name)
+	switch r.Mode {
+	case ManagedResourceMode:
+		return fmt.Sprintf("%s.%s", r.Type, r.Name)
+	case DataResourceMode:
+		return fmt.Sprintf("data.%s.%s", r.Type, r.Name)
+	default:
+		panic(fmt.Errorf("unknown resource mode %s", r.Mode))
+	}
 }
 
 // Validate does some basic semantic checking of the configuration.
@@ -236,6 +247,13 @@ func (c *Config) Validate() error {
 						source,
 						v.FullKey()))
 				}
+			case *TerraformVariable:
+				if v.Type == TerraformValueInvalid {
+					errs = append(errs, fmt.Errorf(
+						"%s: invalid terraform variable: %s",
+						source,
+						v.FullKey()))
+				}
 			}
 		}
 	}
@@ -252,6 +270,14 @@ func (c *Config) Validate() error {
 		}
 
 		providerSet[name] = struct{}{}
+
+		if p.Version != "" {
+			if _, err := version.NewConstraint(p.Version); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"provider.%s: invalid version constraint %q: %s",
+					name, p.Version, err))
+			}
+		}
 	}
 
 	// Check that all references to modules are valid
@@ -376,17 +402,12 @@ func (c *Config) Validate() error {
 					"%s: resource count can't reference count variable: %s",
 					n,
 					v.FullKey()))
-			case *ModuleVariable:
-				errs = append(errs, fmt.Errorf(
-					"%s: resource count can't reference module variable: %s",
-					n,
-					v.FullKey()))
 			case *ResourceVariable:
 				errs = append(errs, fmt.Errorf(
 					"%s: resource count can't reference resource variable: %s",
 					n,
 					v.FullKey()))
-			case *UserVariable:
+			case *ModuleVariable, *UserVariable:
 				// Good
 			default:
 				panic("Unknown type in count var: " + n)
@@ -486,7 +507,7 @@ func (c *Config) Validate() error {
 				continue
 			}
 
-			id := fmt.Sprintf("%s.%s", rv.Type, rv.Name)
+			id := rv.ResourceId()
 			if _, ok := resources[id]; !ok {
 				errs = append(errs, fmt.Errorf(
 					"%s: unknown resource '%s' referenced in variable %s",
@@ -711,13 +732,14 @@ func (c *ProviderConfig) mergerMerge(m merger) merger {
 }
 
 func (r *Resource) mergerName() string {
-	return fmt.Sprintf("%s.%s", r.Type, r.Name)
+	return r.Id()
 }
 
 func (r *Resource) mergerMerge(m merger) merger {
 	r2 := m.(*Resource)
 
 	result := *r
+	result.Mode = r2.Mode
 	result.Name = r2.Name
 	result.Type = r2.Type
 	result.RawConfig = result.RawConfig.merge(r2.RawConfig)
@@ -179,6 +179,9 @@ func resourcesStr(rs []*Resource) string {
 	mapping := make(map[string]int)
 	for i, r := range rs {
 		k := fmt.Sprintf("%s[%s]", r.Type, r.Name)
+		if r.Mode == DataResourceMode {
+			k = "data." + k
+		}
 		ks = append(ks, k)
 		mapping[k] = i
 	}
@@ -189,8 +192,13 @@ func resourcesStr(rs []*Resource) string {
 
 	for _, i := range order {
 		r := rs[i]
+		mode := ""
+		if r.Mode == DataResourceMode {
+			mode = "data."
+		}
 		result += fmt.Sprintf(
-			"%s[%s] (x%s)\n",
+			"%s%s[%s] (x%s)\n",
+			mode,
 			r.Type,
 			r.Name,
 			r.RawCount.Value())
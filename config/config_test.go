@@ -86,8 +86,8 @@ func TestConfigValidate_countCountVar(t *testing.T) {
 
 func TestConfigValidate_countModuleVar(t *testing.T) {
 	c := testConfig(t, "validate-count-module-var")
-	if err := c.Validate(); err == nil {
-		t.Fatal("should not be valid")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
 	}
 }
 
@@ -210,6 +210,20 @@ func TestConfigValidate_pathVarInvalid(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_terraformVar(t *testing.T) {
+	c := testConfig(t, "validate-terraform-var")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestConfigValidate_terraformVarInvalid(t *testing.T) {
+	c := testConfig(t, "validate-terraform-var-invalid")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_providerMulti(t *testing.T) {
 	c := testConfig(t, "validate-provider-multi")
 	if err := c.Validate(); err == nil {
@@ -217,6 +231,20 @@ func TestConfigValidate_providerMulti(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_providerVersionBad(t *testing.T) {
+	c := testConfig(t, "validate-provider-version-bad")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
+func TestConfigValidate_providerVersionGood(t *testing.T) {
+	c := testConfig(t, "validate-provider-version-good")
+	if err := c.Validate(); err != nil {
+		t.Fatalf("should be valid: %s", err)
+	}
+}
+
 func TestConfigValidate_providerMultiGood(t *testing.T) {
 	c := testConfig(t, "validate-provider-multi-good")
 	if err := c.Validate(); err != nil {
@@ -294,6 +322,13 @@ func TestConfigValidate_unknownResourceVar(t *testing.T) {
 	}
 }
 
+func TestConfigValidate_unknownDataVar(t *testing.T) {
+	c := testConfig(t, "validate-unknown-data-var")
+	if err := c.Validate(); err == nil {
+		t.Fatal("should not be valid")
+	}
+}
+
 func TestConfigValidate_unknownResourceVar_output(t *testing.T) {
 	c := testConfig(t, "validate-unknown-resource-var-output")
 	if err := c.Validate(); err == nil {
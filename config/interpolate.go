@@ -55,9 +55,24 @@ const (
 	PathValueRoot
 )
 
+// A TerraformVariable is a variable that references information about
+// the Terraform run itself, such as "${terraform.env}"
+type TerraformVariable struct {
+	Type TerraformValueType
+	key  string
+}
+
+type TerraformValueType byte
+
+const (
+	TerraformValueInvalid TerraformValueType = iota
+	TerraformValueEnv
+)
+
 // A ResourceVariable is a variable that is referencing the field
 // of a resource, such as "${aws_instance.foo.ami}"
 type ResourceVariable struct {
+	Mode  ResourceMode
 	Type  string // Resource type, i.e. "aws_instance"
 	Name  string // Resource name
 	Field string // Resource field
@@ -97,6 +112,8 @@ func NewInterpolatedVariable(v string) (InterpolatedVariable, error) {
 		return NewUserVariable(v)
 	} else if strings.HasPrefix(v, "module.") {
 		return NewModuleVariable(v)
+	} else if strings.HasPrefix(v, "terraform.") {
+		return NewTerraformVariable(v)
 	} else {
 		return NewResourceVariable(v)
 	}
@@ -161,12 +178,47 @@ func (v *PathVariable) FullKey() string {
 	return v.key
 }
 
+func NewTerraformVariable(key string) (*TerraformVariable, error) {
+	var fieldType TerraformValueType
+	parts := strings.SplitN(key, ".", 2)
+	switch parts[1] {
+	case "env":
+		fieldType = TerraformValueEnv
+	}
+
+	return &TerraformVariable{
+		Type: fieldType,
+		key:  key,
+	}, nil
+}
+
+func (v *TerraformVariable) FullKey() string {
+	return v.key
+}
+
 func NewResourceVariable(key string) (*ResourceVariable, error) {
-	parts := strings.SplitN(key, ".", 3)
-	if len(parts) < 3 {
-		return nil, fmt.Errorf(
-			"%s: resource variables must be three parts: type.name.attr",
-			key)
+	var mode ResourceMode
+	var parts []string
+	if strings.HasPrefix(key, "data.") {
+		mode = DataResourceMode
+		parts = strings.SplitN(key, ".", 4)
+		if len(parts) < 4 {
+			return nil, fmt.Errorf(
+				"%s: data variables must be four parts: data.type.name.attr",
+				key)
+		}
+
+		// Don't actually need the "data." prefix for parsing, since it's
+		// always constant.
+		parts = parts[1:]
+	} else {
+		mode = ManagedResourceMode
+		parts = strings.SplitN(key, ".", 3)
+		if len(parts) < 3 {
+			return nil, fmt.Errorf(
+				"%s: resource variables must be three parts: type.name.attr",
+				key)
+		}
 	}
 
 	field := parts[2]
@@ -192,6 +244,7 @@ func NewResourceVariable(key string) (*ResourceVariable, error) {
 	}
 
 	return &ResourceVariable{
+		Mode:  mode,
 		Type:  parts[0],
 		Name:  parts[1],
 		Field: field,
@@ -202,7 +255,14 @@ func NewResourceVariable(key string) (*ResourceVariable, error) {
 }
 
 func (v *ResourceVariable) ResourceId() string {
-	return fmt.Sprintf("%s.%s", v.Type, v.Name)
+	switch v.Mode {
+	case ManagedResourceMode:
+		return fmt.Sprintf("%s.%s", v.Type, v.Name)
+	case DataResourceMode:
+		return fmt.Sprintf("data.%s.%s", v.Type, v.Name)
+	default:
+		panic(fmt.Errorf("unknown resource mode %s", v.Mode))
+	}
 }
 
 func (v *ResourceVariable) FullKey() string {
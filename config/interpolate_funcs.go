@@ -2,7 +2,10 @@ package config
 
 import (
 	"bytes"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -25,6 +28,7 @@ func init() {
 		"cidrhost":     interpolationFuncCidrHost(),
 		"cidrnetmask":  interpolationFuncCidrNetmask(),
 		"cidrsubnet":   interpolationFuncCidrSubnet(),
+		"coalesce":     interpolationFuncCoalesce(),
 		"compact":      interpolationFuncCompact(),
 		"concat":       interpolationFuncConcat(),
 		"element":      interpolationFuncElement(),
@@ -33,8 +37,10 @@ func init() {
 		"formatlist":   interpolationFuncFormatList(),
 		"index":        interpolationFuncIndex(),
 		"join":         interpolationFuncJoin(),
+		"jsonencode":   interpolationFuncJSONEncode(),
 		"length":       interpolationFuncLength(),
 		"lower":        interpolationFuncLower(),
+		"md5":          interpolationFuncMd5(),
 		"replace":      interpolationFuncReplace(),
 		"split":        interpolationFuncSplit(),
 		"base64encode": interpolationFuncBase64Encode(),
@@ -145,6 +151,30 @@ func interpolationFuncCidrSubnet() ast.Function {
 	}
 }
 
+// interpolationFuncCoalesce implements the "coalesce" function that
+// returns the first non-empty argument.
+func interpolationFuncCoalesce() ast.Function {
+	return ast.Function{
+		ArgTypes:     []ast.Type{ast.TypeString},
+		ReturnType:   ast.TypeString,
+		Variadic:     true,
+		VariadicType: ast.TypeString,
+		Callback: func(args []interface{}) (interface{}, error) {
+			if len(args) < 2 {
+				return nil, fmt.Errorf("must provide at least two arguments")
+			}
+			for _, arg := range args {
+				argument := arg.(string)
+
+				if argument != "" {
+					return argument, nil
+				}
+			}
+			return "", nil
+		},
+	}
+}
+
 // interpolationFuncConcat implements the "concat" function that
 // concatenates multiple strings. This isn't actually necessary anymore
 // since our language supports string concat natively, but for backwards
@@ -389,6 +419,27 @@ func interpolationFuncSplit() ast.Function {
 	}
 }
 
+// interpolationFuncJSONEncode implements the "jsonencode" function that
+// encodes a string or a list of strings as JSON.
+func interpolationFuncJSONEncode() ast.Function {
+	return ast.Function{
+		ArgTypes:   []ast.Type{ast.TypeString},
+		ReturnType: ast.TypeString,
+		Callback: func(args []interface{}) (interface{}, error) {
+			var toEncode interface{} = args[0].(string)
+			if IsStringList(args[0].(string)) {
+				toEncode = StringList(args[0].(string)).Slice()
+			}
+
+			jEnc, err := json.Marshal(toEncode)
+			if err != nil {
+				return "", fmt.Errorf("failed to encode JSON data '%s'", toEncode)
+			}
+			return string(jEnc), nil
+		},
+	}
+}
+
 // interpolationFuncLookup implements the "lookup" function that allows
 // dynamic lookups of map types within a Terraform configuration.
 func interpolationFuncLookup(vs map[string]ast.Variable) ast.Function {
@@ -561,3 +612,17 @@ func interpolationFuncUpper() ast.Function {
 		},
 	}
 }
+
+// interpolationFuncMd5 implements the "md5" function that returns the
+// hex encoded MD5 hash of a string.
+func interpolationFuncMd5() ast.Function {
+	return ast.Function{
+		ArgTypes:   []ast.Type{ast.TypeString},
+		ReturnType: ast.TypeString,
+		Callback: func(args []interface{}) (interface{}, error) {
+			s := args[0].(string)
+			h := md5.Sum([]byte(s))
+			return hex.EncodeToString(h[:]), nil
+		},
+	}
+}
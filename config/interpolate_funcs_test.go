@@ -739,6 +739,67 @@ func TestInterpolateFuncBase64Encode(t *testing.T) {
 	})
 }
 
+func TestInterpolateFuncCoalesce(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${coalesce("first", "second", "third")}`,
+				"first",
+				false,
+			},
+			{
+				`${coalesce("", "second", "third")}`,
+				"second",
+				false,
+			},
+			{
+				`${coalesce("", "", "")}`,
+				"",
+				false,
+			},
+			{
+				`${coalesce("foo")}`,
+				nil,
+				true,
+			},
+		},
+	})
+}
+
+func TestInterpolateFuncJSONEncode(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${jsonencode("test")}`,
+				`"test"`,
+				false,
+			},
+			{
+				`${jsonencode(split(",", "a,b,c"))}`,
+				`["a","b","c"]`,
+				false,
+			},
+		},
+	})
+}
+
+func TestInterpolateFuncMd5(t *testing.T) {
+	testFunction(t, testFunctionConfig{
+		Cases: []testFunctionCase{
+			{
+				`${md5("tada")}`,
+				"ce47d07243bb6eaf5e1322c81baf9bbf",
+				false,
+			},
+			{
+				`${md5("")}`,
+				"d41d8cd98f00b204e9800998ecf8427e",
+				false,
+			},
+		},
+	})
+}
+
 func TestInterpolateFuncBase64Decode(t *testing.T) {
 	testFunction(t, testFunctionConfig{
 		Cases: []testFunctionCase{
@@ -54,6 +54,22 @@ func TestNewInterpolatedVariable(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"terraform.env",
+			&TerraformVariable{
+				Type: TerraformValueEnv,
+				key:  "terraform.env",
+			},
+			false,
+		},
+		{
+			"terraform.nope",
+			&TerraformVariable{
+				Type: TerraformValueInvalid,
+				key:  "terraform.nope",
+			},
+			false,
+		},
 		{
 			"self.address",
 			&SelfVariable{
@@ -99,6 +115,33 @@ func TestNewResourceVariable(t *testing.T) {
 	}
 }
 
+func TestNewResourceVariableData(t *testing.T) {
+	v, err := NewResourceVariable("data.foo.bar.baz")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if v.Mode != DataResourceMode {
+		t.Fatalf("bad: %#v", v)
+	}
+	if v.Type != "foo" {
+		t.Fatalf("bad: %#v", v)
+	}
+	if v.Name != "bar" {
+		t.Fatalf("bad: %#v", v)
+	}
+	if v.Field != "baz" {
+		t.Fatalf("bad: %#v", v)
+	}
+	if v.ResourceId() != "data.foo.bar" {
+		t.Fatalf("bad: %#v", v.ResourceId())
+	}
+
+	if v.FullKey() != "data.foo.bar.baz" {
+		t.Fatalf("bad: %#v", v)
+	}
+}
+
 func TestNewUserVariable(t *testing.T) {
 	v, err := NewUserVariable("var.bar")
 	if err != nil {
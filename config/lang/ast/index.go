@@ -0,0 +1,34 @@
+package ast
+
+import (
+	"fmt"
+)
+
+// Index represents an indexed access into a list, such as "foo[1]".
+type Index struct {
+	Target Node
+	Key    Node
+	Posx   Pos
+}
+
+func (n *Index) Accept(v Visitor) Node {
+	n.Target = n.Target.Accept(v)
+	n.Key = n.Key.Accept(v)
+	return v(n)
+}
+
+func (n *Index) Pos() Pos {
+	return n.Posx
+}
+
+func (n *Index) String() string {
+	return fmt.Sprintf("Index(%s, %s)", n.Target, n.Key)
+}
+
+func (n *Index) Type(Scope) (Type, error) {
+	return TypeString, nil
+}
+
+func (n *Index) GoString() string {
+	return fmt.Sprintf("*%#v", *n)
+}
@@ -0,0 +1,28 @@
+package ast
+
+import (
+	"testing"
+)
+
+func TestIndexType(t *testing.T) {
+	i := &Index{
+		Target: &VariableAccess{Name: "foo"},
+		Key: &LiteralNode{
+			Value: 1,
+			Typex: TypeInt,
+		},
+	}
+	scope := &BasicScope{
+		VarMap: map[string]Variable{
+			"foo": Variable{Type: TypeString},
+		},
+	}
+
+	actual, err := i.Type(scope)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if actual != TypeString {
+		t.Fatalf("bad: %s", actual)
+	}
+}
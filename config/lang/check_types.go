@@ -64,6 +64,9 @@ func (v *TypeCheck) visit(raw ast.Node) ast.Node {
 	case *ast.Concat:
 		tc := &typeCheckConcat{n}
 		result, err = tc.TypeCheck(v)
+	case *ast.Index:
+		tc := &typeCheckIndex{n}
+		result, err = tc.TypeCheck(v)
 	case *ast.LiteralNode:
 		tc := &typeCheckLiteral{n}
 		result, err = tc.TypeCheck(v)
@@ -258,6 +261,38 @@ func (tc *typeCheckConcat) TypeCheck(v *TypeCheck) (ast.Node, error) {
 	return n, nil
 }
 
+type typeCheckIndex struct {
+	n *ast.Index
+}
+
+func (tc *typeCheckIndex) TypeCheck(v *TypeCheck) (ast.Node, error) {
+	// The key is on top of the stack, the target is below it
+	keyType := v.StackPop()
+	targetType := v.StackPop()
+
+	// The target must be a list, which is represented as a string
+	if targetType != ast.TypeString {
+		return nil, fmt.Errorf(
+			"cannot index into %s, must be a list", targetType)
+	}
+
+	// The key must be an int
+	if keyType != ast.TypeInt {
+		cn := v.ImplicitConversion(keyType, ast.TypeInt, tc.n.Key)
+		if cn == nil {
+			return nil, fmt.Errorf(
+				"index must be %s, got %s", ast.TypeInt, keyType)
+		}
+
+		tc.n.Key = cn
+	}
+
+	// Indexing always results in a single string element
+	v.StackPush(ast.TypeString)
+
+	return tc.n, nil
+}
+
 type typeCheckLiteral struct {
 	n *ast.LiteralNode
 }
@@ -159,6 +159,45 @@ func TestTypeCheck(t *testing.T) {
 			},
 			true,
 		},
+
+		{
+			"foo ${bar[1]}",
+			&ast.BasicScope{
+				VarMap: map[string]ast.Variable{
+					"bar": ast.Variable{
+						Value: "baz",
+						Type:  ast.TypeString,
+					},
+				},
+			},
+			false,
+		},
+
+		{
+			"foo ${bar[1]}",
+			&ast.BasicScope{
+				VarMap: map[string]ast.Variable{
+					"bar": ast.Variable{
+						Value: 42,
+						Type:  ast.TypeInt,
+					},
+				},
+			},
+			true,
+		},
+
+		{
+			`foo ${bar["1"]}`,
+			&ast.BasicScope{
+				VarMap: map[string]ast.Variable{
+					"bar": ast.Variable{
+						Value: "baz",
+						Type:  ast.TypeString,
+					},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -3,11 +3,28 @@ package lang
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/hashicorp/terraform/config/lang/ast"
 )
 
+// StringListDelim is the delimiter used to recognize and split lists,
+// which are passed around as strings.
+//
+// It plays two semantic roles:
+//   - It introduces a list
+//   - It terminates each element
+//
+// Example representations:
+// []             => SLD
+// [""]           => SLDSLD
+// [" "]          => SLD SLD
+// ["foo"]        => SLDfooSLD
+// ["foo", "bar"] => SLDfooSLDbarSLD
+// ["", ""]       => SLDSLDSLD
+const StringListDelim = `B780FFEC-B661-4EB8-9236-A01737AD98B6`
+
 // EvalConfig is the configuration for evaluating.
 type EvalConfig struct {
 	// GlobalScope is the global scope of execution for evaluation.
@@ -144,6 +161,8 @@ func evalNode(raw ast.Node) (EvalNode, error) {
 		return &evalCall{n}, nil
 	case *ast.Concat:
 		return &evalConcat{n}, nil
+	case *ast.Index:
+		return &evalIndex{n}, nil
 	case *ast.LiteralNode:
 		return &evalLiteralNode{n}, nil
 	case *ast.VariableAccess:
@@ -202,6 +221,35 @@ func (v *evalConcat) Eval(s ast.Scope, stack *ast.Stack) (interface{}, ast.Type,
 	return buf.String(), ast.TypeString, nil
 }
 
+type evalIndex struct{ *ast.Index }
+
+func (v *evalIndex) Eval(s ast.Scope, stack *ast.Stack) (interface{}, ast.Type, error) {
+	// The key was evaluated after the target, so it is on top.
+	key := stack.Pop().(*ast.LiteralNode).Value.(int)
+	target := stack.Pop().(*ast.LiteralNode).Value.(string)
+	name := v.Target.(*ast.VariableAccess).Name
+
+	if !strings.Contains(target, StringListDelim) {
+		return nil, ast.TypeInvalid, fmt.Errorf("%s is not a list", name)
+	}
+
+	// Strip the leading and trailing delimiters and split the rest up
+	// into the list elements. An empty list is a single delimiter.
+	var elems []string
+	parts := strings.Split(target, StringListDelim)
+	if len(parts) > 2 {
+		elems = parts[1 : len(parts)-1]
+	}
+
+	if key < 0 || key >= len(elems) {
+		return nil, ast.TypeInvalid, fmt.Errorf(
+			"index %d out of range for %s (length %d)",
+			key, name, len(elems))
+	}
+
+	return elems[key], ast.TypeString, nil
+}
+
 type evalLiteralNode struct{ *ast.LiteralNode }
 
 func (v *evalLiteralNode) Eval(ast.Scope, *ast.Stack) (interface{}, ast.Type, error) {
@@ -243,6 +243,40 @@ func TestEval(t *testing.T) {
 			ast.TypeString,
 		},
 
+		// Indexing lists
+
+		{
+			"foo ${bar[1]}",
+			&ast.BasicScope{
+				VarMap: map[string]ast.Variable{
+					"bar": ast.Variable{
+						Value: StringListDelim + "a" + StringListDelim +
+							"b" + StringListDelim,
+						Type: ast.TypeString,
+					},
+				},
+			},
+			false,
+			"foo b",
+			ast.TypeString,
+		},
+
+		{
+			`foo ${bar["0"]}`,
+			&ast.BasicScope{
+				VarMap: map[string]ast.Variable{
+					"bar": ast.Variable{
+						Value: StringListDelim + "a" + StringListDelim +
+							"b" + StringListDelim,
+						Type: ast.TypeString,
+					},
+				},
+			},
+			false,
+			"foo a",
+			ast.TypeString,
+		},
+
 		// Multiline
 		{
 			"foo ${42+\n1.0}",
@@ -271,3 +305,53 @@ func TestEval(t *testing.T) {
 		}
 	}
 }
+
+func TestEval_indexError(t *testing.T) {
+	cases := []struct {
+		Input string
+		Value string
+		Error string
+	}{
+		{
+			"${bar[2]}",
+			StringListDelim + "a" + StringListDelim + "b" + StringListDelim,
+			"index 2 out of range for bar (length 2)",
+		},
+
+		{
+			"${bar[0]}",
+			StringListDelim,
+			"index 0 out of range for bar (length 0)",
+		},
+
+		{
+			"${bar[0]}",
+			"a",
+			"bar is not a list",
+		},
+	}
+
+	for _, tc := range cases {
+		node, err := Parse(tc.Input)
+		if err != nil {
+			t.Fatalf("Error: %s\n\nInput: %s", err, tc.Input)
+		}
+
+		scope := &ast.BasicScope{
+			VarMap: map[string]ast.Variable{
+				"bar": ast.Variable{
+					Value: tc.Value,
+					Type:  ast.TypeString,
+				},
+			},
+		}
+
+		_, _, err = Eval(node, &EvalConfig{GlobalScope: scope})
+		if err == nil {
+			t.Fatalf("should error\n\nInput: %s", tc.Input)
+		}
+		if err.Error() != tc.Error {
+			t.Fatalf("Bad: %s\n\nInput: %s", err, tc.Input)
+		}
+	}
+}
@@ -168,6 +168,10 @@ func (x *parserLex) lexModeInterpolation(yylval *parserSymType) int {
 			return PAREN_RIGHT
 		case ',':
 			return COMMA
+		case '[':
+			return SQUARE_BRACKET_LEFT
+		case ']':
+			return SQUARE_BRACKET_RIGHT
 		case '+':
 			yylval.token = &parserToken{Value: ast.ArithmeticOpAdd}
 			return ARITH_OP
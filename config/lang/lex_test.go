@@ -106,6 +106,14 @@ func TestLex(t *testing.T) {
 				PROGRAM_BRACKET_RIGHT, lexEOF},
 		},
 
+		{
+			"foo ${foo.bar[count.index]}",
+			[]int{STRING, PROGRAM_BRACKET_LEFT,
+				IDENTIFIER, SQUARE_BRACKET_LEFT, IDENTIFIER,
+				SQUARE_BRACKET_RIGHT,
+				PROGRAM_BRACKET_RIGHT, lexEOF},
+		},
+
 		{
 			`foo ${"${var.foo}"}`,
 			[]int{STRING, PROGRAM_BRACKET_LEFT,
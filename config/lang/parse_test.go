@@ -332,6 +332,85 @@ func TestParse(t *testing.T) {
 			},
 		},
 
+		{
+			"${foo.bar[count.index]}",
+			false,
+			&ast.Concat{
+				Posx: ast.Pos{Column: 3, Line: 1},
+				Exprs: []ast.Node{
+					&ast.Index{
+						Target: &ast.VariableAccess{
+							Name: "foo.bar",
+							Posx: ast.Pos{Column: 3, Line: 1},
+						},
+						Key: &ast.VariableAccess{
+							Name: "count.index",
+							Posx: ast.Pos{Column: 11, Line: 1},
+						},
+						Posx: ast.Pos{Column: 3, Line: 1},
+					},
+				},
+			},
+		},
+
+		{
+			"${foo[bar[1] + 1]}",
+			false,
+			&ast.Concat{
+				Posx: ast.Pos{Column: 3, Line: 1},
+				Exprs: []ast.Node{
+					&ast.Index{
+						Target: &ast.VariableAccess{
+							Name: "foo",
+							Posx: ast.Pos{Column: 3, Line: 1},
+						},
+						Key: &ast.Arithmetic{
+							Op: ast.ArithmeticOpAdd,
+							Exprs: []ast.Node{
+								&ast.Index{
+									Target: &ast.VariableAccess{
+										Name: "bar",
+										Posx: ast.Pos{Column: 7, Line: 1},
+									},
+									Key: &ast.LiteralNode{
+										Value: 1,
+										Typex: ast.TypeInt,
+										Posx:  ast.Pos{Column: 11, Line: 1},
+									},
+									Posx: ast.Pos{Column: 7, Line: 1},
+								},
+								&ast.LiteralNode{
+									Value: 1,
+									Typex: ast.TypeInt,
+									Posx:  ast.Pos{Column: 15, Line: 1},
+								},
+							},
+							Posx: ast.Pos{Column: 7, Line: 1},
+						},
+						Posx: ast.Pos{Column: 3, Line: 1},
+					},
+				},
+			},
+		},
+
+		{
+			"${foo[]}",
+			true,
+			nil,
+		},
+
+		{
+			"${foo[1}",
+			true,
+			nil,
+		},
+
+		{
+			"${foo]}",
+			true,
+			nil,
+		},
+
 		{
 			`foo ${bar ${baz}}`,
 			true,
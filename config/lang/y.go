@@ -4,6 +4,7 @@ package lang
 import __yyfmt__ "fmt"
 
 //line lang.y:6
+
 import (
 	"github.com/hashicorp/terraform/config/lang/ast"
 )
@@ -24,11 +25,13 @@ const PROGRAM_STRING_END = 57349
 const PAREN_LEFT = 57350
 const PAREN_RIGHT = 57351
 const COMMA = 57352
-const ARITH_OP = 57353
-const IDENTIFIER = 57354
-const INTEGER = 57355
-const FLOAT = 57356
-const STRING = 57357
+const SQUARE_BRACKET_LEFT = 57353
+const SQUARE_BRACKET_RIGHT = 57354
+const ARITH_OP = 57355
+const IDENTIFIER = 57356
+const INTEGER = 57357
+const FLOAT = 57358
+const STRING = 57359
 
 var parserToknames = [...]string{
 	"$end",
@@ -41,83 +44,83 @@ var parserToknames = [...]string{
 	"PAREN_LEFT",
 	"PAREN_RIGHT",
 	"COMMA",
+	"SQUARE_BRACKET_LEFT",
+	"SQUARE_BRACKET_RIGHT",
 	"ARITH_OP",
 	"IDENTIFIER",
 	"INTEGER",
 	"FLOAT",
 	"STRING",
 }
+
 var parserStatenames = [...]string{}
 
 const parserEofCode = 1
 const parserErrCode = 2
-const parserMaxDepth = 200
+const parserInitialStackSize = 16
 
-//line lang.y:165
+//line lang.y:177
 
 //line yacctab:1
-var parserExca = [...]int{
+var parserExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
 }
 
-const parserNprod = 19
 const parserPrivate = 57344
 
-var parserTokenNames []string
-var parserStates []string
-
-const parserLast = 30
-
-var parserAct = [...]int{
+const parserLast = 34
 
-	9, 20, 16, 16, 7, 7, 3, 18, 10, 8,
-	1, 17, 14, 12, 13, 6, 6, 19, 8, 22,
-	15, 23, 24, 11, 2, 25, 16, 21, 4, 5,
+var parserAct = [...]int8{
+	9, 15, 7, 7, 27, 16, 10, 16, 1, 16,
+	22, 17, 14, 12, 13, 6, 6, 20, 21, 23,
+	24, 3, 16, 18, 8, 4, 19, 28, 25, 26,
+	11, 2, 5, 8,
 }
-var parserPact = [...]int{
 
-	1, -1000, 1, -1000, -1000, -1000, -1000, 0, -1000, 15,
-	0, 1, -1000, -1000, -1, -1000, 0, -8, 0, -1000,
-	-1000, 12, -9, -1000, 0, -9,
+var parserPact = [...]int16{
+	-1, -32768, -1, -32768, -32768, -32768, -32768, -2, -32768, -4,
+	-2, -1, -32768, -32768, 15, -32768, -2, 9, -2, -2,
+	-32768, -32768, 19, -6, -8, -32768, -2, -32768, -6,
 }
-var parserPgo = [...]int{
 
-	0, 0, 29, 28, 23, 6, 27, 10,
+var parserPgo = [...]int8{
+	0, 0, 32, 25, 30, 21, 10, 8,
 }
-var parserR1 = [...]int{
 
+var parserR1 = [...]int8{
 	0, 7, 7, 4, 4, 5, 5, 2, 1, 1,
-	1, 1, 1, 1, 1, 6, 6, 6, 3,
+	1, 1, 1, 1, 1, 1, 6, 6, 6, 3,
 }
-var parserR2 = [...]int{
 
+var parserR2 = [...]int8{
 	0, 0, 1, 1, 2, 1, 1, 3, 3, 1,
-	1, 1, 3, 1, 4, 0, 3, 1, 1,
+	1, 1, 3, 1, 4, 4, 0, 3, 1, 1,
 }
-var parserChk = [...]int{
 
-	-1000, -7, -4, -5, -3, -2, 15, 4, -5, -1,
-	8, -4, 13, 14, 12, 5, 11, -1, 8, -1,
-	9, -6, -1, 9, 10, -1,
+var parserChk = [...]int16{
+	-32768, -7, -4, -5, -3, -2, 17, 4, -5, -1,
+	8, -4, 15, 16, 14, 5, 13, -1, 8, 11,
+	-1, 9, -6, -1, -1, 9, 10, 12, -1,
 }
-var parserDef = [...]int{
 
-	1, -2, 2, 3, 5, 6, 18, 0, 4, 0,
-	0, 9, 10, 11, 13, 7, 0, 0, 15, 12,
-	8, 0, 17, 14, 0, 16,
+var parserDef = [...]int8{
+	1, -2, 2, 3, 5, 6, 19, 0, 4, 0,
+	0, 9, 10, 11, 13, 7, 0, 0, 16, 0,
+	12, 8, 0, 18, 0, 14, 0, 15, 17,
 }
-var parserTok1 = [...]int{
 
+var parserTok1 = [...]int8{
 	1,
 }
-var parserTok2 = [...]int{
 
+var parserTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
-	12, 13, 14, 15,
+	12, 13, 14, 15, 16, 17,
 }
-var parserTok3 = [...]int{
+
+var parserTok3 = [...]int8{
 	0,
 }
 
@@ -147,21 +150,20 @@ type parserParser interface {
 }
 
 type parserParserImpl struct {
-	lookahead func() int
+	lval  parserSymType
+	stack [parserInitialStackSize]parserSymType
+	char  int
 }
 
 func (p *parserParserImpl) Lookahead() int {
-	return p.lookahead()
+	return p.char
 }
 
 func parserNewParser() parserParser {
-	p := &parserParserImpl{
-		lookahead: func() int { return -1 },
-	}
-	return p
+	return &parserParserImpl{}
 }
 
-const parserFlag = -1000
+const parserFlag = -32768
 
 func parserTokname(c int) string {
 	if c >= 1 && c-1 < len(parserToknames) {
@@ -200,9 +202,9 @@ func parserErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := parserPact[state]
+	base := int(parserPact[state])
 	for tok := TOKSTART; tok-1 < len(parserToknames); tok++ {
-		if n := base + tok; n >= 0 && n < parserLast && parserChk[parserAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < parserLast && int(parserChk[int(parserAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -212,13 +214,13 @@ func parserErrorMessage(state, lookAhead int) string {
 
 	if parserDef[state] == -2 {
 		i := 0
-		for parserExca[i] != -1 || parserExca[i+1] != state {
+		for parserExca[i] != -1 || int(parserExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; parserExca[i] >= 0; i += 2 {
-			tok := parserExca[i]
+			tok := int(parserExca[i])
 			if tok < TOKSTART || parserExca[i+1] == 0 {
 				continue
 			}
@@ -249,30 +251,30 @@ func parserlex1(lex parserLexer, lval *parserSymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = parserTok1[0]
+		token = int(parserTok1[0])
 		goto out
 	}
 	if char < len(parserTok1) {
-		token = parserTok1[char]
+		token = int(parserTok1[char])
 		goto out
 	}
 	if char >= parserPrivate {
 		if char < parserPrivate+len(parserTok2) {
-			token = parserTok2[char-parserPrivate]
+			token = int(parserTok2[char-parserPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(parserTok3); i += 2 {
-		token = parserTok3[i+0]
+		token = int(parserTok3[i+0])
 		if token == char {
-			token = parserTok3[i+1]
+			token = int(parserTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = parserTok2[1] /* unknown char */
+		token = int(parserTok2[1]) /* unknown char */
 	}
 	if parserDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", parserTokname(token), uint(char))
@@ -286,22 +288,20 @@ func parserParse(parserlex parserLexer) int {
 
 func (parserrcvr *parserParserImpl) Parse(parserlex parserLexer) int {
 	var parsern int
-	var parserlval parserSymType
 	var parserVAL parserSymType
 	var parserDollar []parserSymType
 	_ = parserDollar // silence set and not used
-	parserS := make([]parserSymType, parserMaxDepth)
+	parserS := parserrcvr.stack[:]
 
 	Nerrs := 0   /* number of errors */
 	Errflag := 0 /* error recovery flag */
 	parserstate := 0
-	parserchar := -1
-	parsertoken := -1 // parserchar translated into internal numbering
-	parserrcvr.lookahead = func() int { return parserchar }
+	parserrcvr.char = -1
+	parsertoken := -1 // parserrcvr.char translated into internal numbering
 	defer func() {
 		// Make sure we report no lookahead when not parsing.
 		parserstate = -1
-		parserchar = -1
+		parserrcvr.char = -1
 		parsertoken = -1
 	}()
 	parserp := -1
@@ -329,22 +329,22 @@ parserstack:
 	parserS[parserp].yys = parserstate
 
 parsernewstate:
-	parsern = parserPact[parserstate]
+	parsern = int(parserPact[parserstate])
 	if parsern <= parserFlag {
 		goto parserdefault /* simple state */
 	}
-	if parserchar < 0 {
-		parserchar, parsertoken = parserlex1(parserlex, &parserlval)
+	if parserrcvr.char < 0 {
+		parserrcvr.char, parsertoken = parserlex1(parserlex, &parserrcvr.lval)
 	}
 	parsern += parsertoken
 	if parsern < 0 || parsern >= parserLast {
 		goto parserdefault
 	}
-	parsern = parserAct[parsern]
-	if parserChk[parsern] == parsertoken { /* valid shift */
-		parserchar = -1
+	parsern = int(parserAct[parsern])
+	if int(parserChk[parsern]) == parsertoken { /* valid shift */
+		parserrcvr.char = -1
 		parsertoken = -1
-		parserVAL = parserlval
+		parserVAL = parserrcvr.lval
 		parserstate = parsern
 		if Errflag > 0 {
 			Errflag--
@@ -354,27 +354,27 @@ parsernewstate:
 
 parserdefault:
 	/* default state action */
-	parsern = parserDef[parserstate]
+	parsern = int(parserDef[parserstate])
 	if parsern == -2 {
-		if parserchar < 0 {
-			parserchar, parsertoken = parserlex1(parserlex, &parserlval)
+		if parserrcvr.char < 0 {
+			parserrcvr.char, parsertoken = parserlex1(parserlex, &parserrcvr.lval)
 		}
 
 		/* look through exception table */
 		xi := 0
 		for {
-			if parserExca[xi+0] == -1 && parserExca[xi+1] == parserstate {
+			if parserExca[xi+0] == -1 && int(parserExca[xi+1]) == parserstate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			parsern = parserExca[xi+0]
+			parsern = int(parserExca[xi+0])
 			if parsern < 0 || parsern == parsertoken {
 				break
 			}
 		}
-		parsern = parserExca[xi+1]
+		parsern = int(parserExca[xi+1])
 		if parsern < 0 {
 			goto ret0
 		}
@@ -396,10 +396,10 @@ parserdefault:
 
 			/* find a state where "error" is a legal shift action */
 			for parserp >= 0 {
-				parsern = parserPact[parserS[parserp].yys] + parserErrCode
+				parsern = int(parserPact[parserS[parserp].yys]) + parserErrCode
 				if parsern >= 0 && parsern < parserLast {
-					parserstate = parserAct[parsern] /* simulate a shift of "error" */
-					if parserChk[parserstate] == parserErrCode {
+					parserstate = int(parserAct[parsern]) /* simulate a shift of "error" */
+					if int(parserChk[parserstate]) == parserErrCode {
 						goto parserstack
 					}
 				}
@@ -420,7 +420,7 @@ parserdefault:
 			if parsertoken == parserEofCode {
 				goto ret1
 			}
-			parserchar = -1
+			parserrcvr.char = -1
 			parsertoken = -1
 			goto parsernewstate /* try again in the same state */
 		}
@@ -435,7 +435,7 @@ parserdefault:
 	parserpt := parserp
 	_ = parserpt // guard against "declared and not used"
 
-	parserp -= parserR2[parsern]
+	parserp -= int(parserR2[parsern])
 	// parserp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if parserp+1 >= len(parserS) {
@@ -446,16 +446,16 @@ parserdefault:
 	parserVAL = parserS[parserp+1]
 
 	/* consult goto table to find next state */
-	parsern = parserR1[parsern]
-	parserg := parserPgo[parsern]
+	parsern = int(parserR1[parsern])
+	parserg := int(parserPgo[parsern])
 	parserj := parserg + parserS[parserp].yys + 1
 
 	if parserj >= parserLast {
-		parserstate = parserAct[parserg]
+		parserstate = int(parserAct[parserg])
 	} else {
-		parserstate = parserAct[parserj]
-		if parserChk[parserstate] != -parsern {
-			parserstate = parserAct[parserg]
+		parserstate = int(parserAct[parserj])
+		if int(parserChk[parserstate]) != -parsern {
+			parserstate = int(parserAct[parserg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -463,7 +463,7 @@ parserdefault:
 
 	case 1:
 		parserDollar = parserS[parserpt-0 : parserpt+1]
-		//line lang.y:35
+//line lang.y:36
 		{
 			parserResult = &ast.LiteralNode{
 				Value: "",
@@ -473,7 +473,7 @@ parserdefault:
 		}
 	case 2:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:43
+//line lang.y:44
 		{
 			parserResult = parserDollar[1].node
 
@@ -496,13 +496,13 @@ parserdefault:
 		}
 	case 3:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:66
+//line lang.y:67
 		{
 			parserVAL.node = parserDollar[1].node
 		}
 	case 4:
 		parserDollar = parserS[parserpt-2 : parserpt+1]
-		//line lang.y:70
+//line lang.y:71
 		{
 			var result []ast.Node
 			if c, ok := parserDollar[1].node.(*ast.Concat); ok {
@@ -518,37 +518,37 @@ parserdefault:
 		}
 	case 5:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:86
+//line lang.y:87
 		{
 			parserVAL.node = parserDollar[1].node
 		}
 	case 6:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:90
+//line lang.y:91
 		{
 			parserVAL.node = parserDollar[1].node
 		}
 	case 7:
 		parserDollar = parserS[parserpt-3 : parserpt+1]
-		//line lang.y:96
+//line lang.y:97
 		{
 			parserVAL.node = parserDollar[2].node
 		}
 	case 8:
 		parserDollar = parserS[parserpt-3 : parserpt+1]
-		//line lang.y:102
+//line lang.y:103
 		{
 			parserVAL.node = parserDollar[2].node
 		}
 	case 9:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:106
+//line lang.y:107
 		{
 			parserVAL.node = parserDollar[1].node
 		}
 	case 10:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:110
+//line lang.y:111
 		{
 			parserVAL.node = &ast.LiteralNode{
 				Value: parserDollar[1].token.Value.(int),
@@ -558,7 +558,7 @@ parserdefault:
 		}
 	case 11:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:118
+//line lang.y:119
 		{
 			parserVAL.node = &ast.LiteralNode{
 				Value: parserDollar[1].token.Value.(float64),
@@ -568,7 +568,7 @@ parserdefault:
 		}
 	case 12:
 		parserDollar = parserS[parserpt-3 : parserpt+1]
-		//line lang.y:126
+//line lang.y:127
 		{
 			parserVAL.node = &ast.Arithmetic{
 				Op:    parserDollar[2].token.Value.(ast.ArithmeticOp),
@@ -578,37 +578,50 @@ parserdefault:
 		}
 	case 13:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:134
+//line lang.y:135
 		{
 			parserVAL.node = &ast.VariableAccess{Name: parserDollar[1].token.Value.(string), Posx: parserDollar[1].token.Pos}
 		}
 	case 14:
 		parserDollar = parserS[parserpt-4 : parserpt+1]
-		//line lang.y:138
+//line lang.y:139
 		{
 			parserVAL.node = &ast.Call{Func: parserDollar[1].token.Value.(string), Args: parserDollar[3].nodeList, Posx: parserDollar[1].token.Pos}
 		}
 	case 15:
+		parserDollar = parserS[parserpt-4 : parserpt+1]
+//line lang.y:143
+		{
+			parserVAL.node = &ast.Index{
+				Target: &ast.VariableAccess{
+					Name: parserDollar[1].token.Value.(string),
+					Posx: parserDollar[1].token.Pos,
+				},
+				Key:  parserDollar[3].node,
+				Posx: parserDollar[1].token.Pos,
+			}
+		}
+	case 16:
 		parserDollar = parserS[parserpt-0 : parserpt+1]
-		//line lang.y:143
+//line lang.y:155
 		{
 			parserVAL.nodeList = nil
 		}
-	case 16:
+	case 17:
 		parserDollar = parserS[parserpt-3 : parserpt+1]
-		//line lang.y:147
+//line lang.y:159
 		{
 			parserVAL.nodeList = append(parserDollar[1].nodeList, parserDollar[3].node)
 		}
-	case 17:
+	case 18:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:151
+//line lang.y:163
 		{
 			parserVAL.nodeList = append(parserVAL.nodeList, parserDollar[1].node)
 		}
-	case 18:
+	case 19:
 		parserDollar = parserS[parserpt-1 : parserpt+1]
-		//line lang.y:157
+//line lang.y:169
 		{
 			parserVAL.node = &ast.LiteralNode{
 				Value: parserDollar[1].token.Value.(string),
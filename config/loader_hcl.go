@@ -19,6 +19,7 @@ type hclConfigurable struct {
 func (t *hclConfigurable) Config() (*Config, error) {
 	validKeys := map[string]struct{}{
 		"atlas":    struct{}{},
+		"data":     struct{}{},
 		"module":   struct{}{},
 		"output":   struct{}{},
 		"provider": struct{}{},
@@ -108,6 +109,17 @@ func (t *hclConfigurable) Config() (*Config, error) {
 		}
 	}
 
+	// Build the data sources. These share the resources list, with
+	// their Mode telling them apart.
+	if datas := t.Object.Get("data", false); datas != nil {
+		dataResources, err := loadDataResourcesHcl(datas)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Resources = append(config.Resources, dataResources...)
+	}
+
 	// Build the outputs
 	if outputs := t.Object.Get("output", false); outputs != nil {
 		var err error
@@ -306,6 +318,8 @@ func loadOutputsHcl(os *hclobj.Object) ([]*Output, error) {
 			return nil, err
 		}
 
+		delete(config, "sensitive")
+
 		rawConfig, err := NewRawConfig(config)
 		if err != nil {
 			return nil, fmt.Errorf(
@@ -314,8 +328,22 @@ func loadOutputsHcl(os *hclobj.Object) ([]*Output, error) {
 				err)
 		}
 
+		// If the output is marked as sensitive, then its value won't
+		// be shown in the CLI output.
+		var sensitive bool
+		if s := o.Get("sensitive", false); s != nil {
+			err := hcl.DecodeObject(&sensitive, s)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Error reading sensitive for output %s: %s",
+					n,
+					err)
+			}
+		}
+
 		result = append(result, &Output{
 			Name:      n,
+			Sensitive: sensitive,
 			RawConfig: rawConfig,
 		})
 	}
@@ -350,6 +378,7 @@ func loadProvidersHcl(os *hclobj.Object) ([]*ProviderConfig, error) {
 		}
 
 		delete(config, "alias")
+		delete(config, "version")
 
 		rawConfig, err := NewRawConfig(config)
 		if err != nil {
@@ -371,9 +400,22 @@ func loadProvidersHcl(os *hclobj.Object) ([]*ProviderConfig, error) {
 			}
 		}
 
+		// If we have a version constraint, then add that in
+		var version string
+		if v := o.Get("version", false); v != nil {
+			err := hcl.DecodeObject(&version, v)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Error reading version for provider[%s]: %s",
+					o.Key,
+					err)
+			}
+		}
+
 		result = append(result, &ProviderConfig{
 			Name:      o.Key,
 			Alias:     alias,
+			Version:   version,
 			RawConfig: rawConfig,
 		})
 	}
@@ -556,6 +598,131 @@ func loadResourcesHcl(os *hclobj.Object) ([]*Resource, error) {
 	return result, nil
 }
 
+// Given a handle to a HCL object, this recurses into the structure
+// and pulls out a list of data sources.
+//
+// The resulting data sources may not be unique, but each one
+// represents exactly one data definition in the HCL configuration.
+// We leave it up to another pass to merge them together.
+func loadDataResourcesHcl(os *hclobj.Object) ([]*Resource, error) {
+	var allTypes []*hclobj.Object
+
+	// See loadResourcesHcl for why this exists. Don't touch this.
+	for _, o1 := range os.Elem(false) {
+		// Iterate the inner to get the list of types
+		for _, o2 := range o1.Elem(true) {
+			// Iterate all of this type to get _all_ the types
+			for _, o3 := range o2.Elem(false) {
+				allTypes = append(allTypes, o3)
+			}
+		}
+	}
+
+	// Where all the results will go
+	var result []*Resource
+
+	// Now go over all the types and their children in order to get
+	// all of the actual data sources.
+	for _, t := range allTypes {
+		for _, obj := range t.Elem(true) {
+			k := obj.Key
+
+			var config map[string]interface{}
+			if err := hcl.DecodeObject(&config, obj); err != nil {
+				return nil, fmt.Errorf(
+					"Error reading config for data.%s[%s]: %s",
+					t.Key,
+					k,
+					err)
+			}
+
+			// Data sources are never created or destroyed, so the
+			// settings that only affect that lifecycle aren't allowed.
+			for _, invalid := range []string{"connection", "lifecycle", "provisioner"} {
+				if _, ok := config[invalid]; ok {
+					return nil, fmt.Errorf(
+						"data.%s[%s]: %s is not allowed for data sources",
+						t.Key,
+						k,
+						invalid)
+				}
+			}
+
+			// Remove the fields we handle specially
+			delete(config, "count")
+			delete(config, "depends_on")
+			delete(config, "provider")
+
+			rawConfig, err := NewRawConfig(config)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Error reading config for data.%s[%s]: %s",
+					t.Key,
+					k,
+					err)
+			}
+
+			// If we have a count, then figure it out
+			var count string = "1"
+			if o := obj.Get("count", false); o != nil {
+				err = hcl.DecodeObject(&count, o)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"Error parsing count for data.%s[%s]: %s",
+						t.Key,
+						k,
+						err)
+				}
+			}
+			countConfig, err := NewRawConfig(map[string]interface{}{
+				"count": count,
+			})
+			if err != nil {
+				return nil, err
+			}
+			countConfig.Key = "count"
+
+			// If we have depends fields, then add those in
+			var dependsOn []string
+			if o := obj.Get("depends_on", false); o != nil {
+				err := hcl.DecodeObject(&dependsOn, o)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"Error reading depends_on for data.%s[%s]: %s",
+						t.Key,
+						k,
+						err)
+				}
+			}
+
+			// If we have a provider, then parse it out
+			var provider string
+			if o := obj.Get("provider", false); o != nil {
+				err := hcl.DecodeObject(&provider, o)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"Error reading provider for data.%s[%s]: %s",
+						t.Key,
+						k,
+						err)
+				}
+			}
+
+			result = append(result, &Resource{
+				Mode:      DataResourceMode,
+				Name:      k,
+				Type:      t.Key,
+				RawCount:  countConfig,
+				RawConfig: rawConfig,
+				Provider:  provider,
+				DependsOn: dependsOn,
+			})
+		}
+	}
+
+	return result, nil
+}
+
 func loadProvisionersHcl(os *hclobj.Object, connInfo map[string]interface{}) ([]*Provisioner, error) {
 	pos := make([]*hclobj.Object, 0, int(os.Len()))
 
@@ -85,6 +85,50 @@ func TestLoadFileBasic(t *testing.T) {
 	}
 }
 
+func TestLoadFile_providerVersion(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "provider-version.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(c.ProviderConfigs) != 1 {
+		t.Fatalf("bad: %#v", c.ProviderConfigs)
+	}
+
+	p := c.ProviderConfigs[0]
+	if p.Version != ">= 1.2" {
+		t.Fatalf("bad: %#v", p.Version)
+	}
+	if _, ok := p.RawConfig.Raw["version"]; ok {
+		t.Fatalf("version should not be in the raw config: %#v", p.RawConfig.Raw)
+	}
+	if p.RawConfig.Raw["region"] != "us-east-1" {
+		t.Fatalf("bad: %#v", p.RawConfig.Raw)
+	}
+}
+
+func TestLoadFile_outputSensitive(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "output-sensitive.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	outputs := make(map[string]*Output)
+	for _, o := range c.Outputs {
+		outputs[o.Name] = o
+	}
+
+	if o := outputs["password"]; o == nil || !o.Sensitive {
+		t.Fatalf("bad: %#v", o)
+	}
+	if _, ok := outputs["password"].RawConfig.Raw["sensitive"]; ok {
+		t.Fatalf("sensitive should not be in the raw config")
+	}
+	if o := outputs["address"]; o == nil || o.Sensitive {
+		t.Fatalf("bad: %#v", o)
+	}
+}
+
 func TestLoadFileBasic_empty(t *testing.T) {
 	c, err := LoadFile(filepath.Join(fixtureDir, "empty.tf"))
 	if err != nil {
@@ -365,6 +409,33 @@ func TestLoadFile_provisioners(t *testing.T) {
 	}
 }
 
+func TestLoadFile_dataSources(t *testing.T) {
+	c, err := LoadFile(filepath.Join(fixtureDir, "data-source.tf"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c == nil {
+		t.Fatal("config should not be nil")
+	}
+
+	actual := resourcesStr(c.Resources)
+	if actual != strings.TrimSpace(dataSourceResourcesStr) {
+		t.Fatalf("bad:\n%s", actual)
+	}
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestLoadFile_dataSourceProvisioner(t *testing.T) {
+	_, err := LoadFile(filepath.Join(fixtureDir, "data-source-provisioner.tf"))
+	if err == nil {
+		t.Fatal("should have error")
+	}
+}
+
 func TestLoadFile_connections(t *testing.T) {
 	c, err := LoadFile(filepath.Join(fixtureDir, "connection.tf"))
 	if err != nil {
@@ -692,6 +763,15 @@ aws_instance[web] (x1)
     user: var.foo
 `
 
+const dataSourceResourcesStr = `
+aws_instance[web] (x1)
+  ami
+  vars
+    resource: data.aws_ami.web.id
+data.aws_ami[web] (x1)
+  most_recent
+`
+
 const connectionResourcesStr = `
 aws_instance[web] (x1)
   ami
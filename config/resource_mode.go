@@ -0,0 +1,17 @@
+package config
+
+//go:generate stringer -type=ResourceMode resource_mode.go
+
+// ResourceMode is an enum of the ways a resource in the configuration can
+// be managed: either created and destroyed by Terraform, or only read.
+type ResourceMode int
+
+const (
+	// ManagedResourceMode is a "resource" block: Terraform manages the
+	// full lifecycle of the resource.
+	ManagedResourceMode ResourceMode = iota
+
+	// DataResourceMode is a "data" block: Terraform only reads the
+	// resource and never creates or destroys it.
+	DataResourceMode
+)
@@ -3,26 +3,17 @@ package config
 import (
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform/config/lang"
 )
 
 // StringList represents the "poor man's list" that terraform uses
 // internally
 type StringList string
 
-// This is the delimiter used to recognize and split StringLists
-//
-// It plays two semantic roles:
-//   * It introduces a list
-//   * It terminates each element
-//
-// Example representations:
-// []             => SLD
-// [""]           => SLDSLD
-// [" "]          => SLD SLD
-// ["foo"]        => SLDfooSLD
-// ["foo", "bar"] => SLDfooSLDbarSLD
-// ["", ""]       => SLDSLDSLD
-const stringListDelim = `B780FFEC-B661-4EB8-9236-A01737AD98B6`
+// This is the delimiter used to recognize and split StringLists. It is
+// shared with the interpolation language so that lists can be indexed.
+const stringListDelim = lang.StringListDelim
 
 // Takes a Stringlist and returns one without empty strings in it
 func (sl StringList) Compact() StringList {
@@ -0,0 +1,5 @@
+data "aws_ami" "web" {
+    provisioner "shell" {
+        path = "foo"
+    }
+}
@@ -0,0 +1,7 @@
+data "aws_ami" "web" {
+    most_recent = true
+}
+
+resource "aws_instance" "web" {
+    ami = "${data.aws_ami.web.id}"
+}
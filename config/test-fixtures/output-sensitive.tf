@@ -0,0 +1,8 @@
+output "password" {
+    value = "${var.password}"
+    sensitive = true
+}
+
+output "address" {
+    value = "foo"
+}
@@ -0,0 +1,3 @@
+provider "aws" {
+    version = ">= 1.2, < 2.0"
+}
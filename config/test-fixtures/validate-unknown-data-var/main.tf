@@ -0,0 +1,3 @@
+resource "aws_instance" "web" {
+    ami = "${data.aws_ami.web.id}"
+}
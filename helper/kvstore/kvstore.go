@@ -0,0 +1,268 @@
+// The kvstore package contains the logic shared by resources that
+// manage a set of keys in a key/value store, such as consul_keys.
+//
+// A resource using this package has a "key" block for every key it
+// writes or reads, and exposes the values of all the keys in the "var"
+// map. The store itself is abstracted behind the Store interface.
+package kvstore
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Store is a key/value store that keys are written to and read from.
+type Store interface {
+	// Get returns the value of the key at path. The boolean result is
+	// false if the key doesn't exist.
+	Get(path string) (string, bool, error)
+
+	// Put sets the key at path to value.
+	Put(path, value string) error
+
+	// Delete deletes the key at path.
+	Delete(path string) error
+}
+
+// Schema returns the schema of the attributes shared by all key/value
+// resources. Resources add their own attributes, such as the ones used
+// to connect to the store, to the result.
+func Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"key": &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					"path": &schema.Schema{
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					"value": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+
+					"default": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					"delete": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"hash": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+			Set: KeyHash,
+		},
+
+		"var": &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+
+		"detect_drift": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"drifted": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// KeyHash is the hash function for the "key" set. Keys are identified by
+// their name and path only, so that a change of the value is shown as a
+// change of the key rather than as a new key.
+func KeyHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["path"].(string)))
+	return hashcode.String(buf.String())
+}
+
+// Write writes every key that has a value to the store and reads the
+// rest. It is used to both create and update a resource.
+//
+// The hash of the value of every key that is written is stored in the
+// state, so that Read can tell when the key was changed outside of
+// Terraform. Keys that are only read have no hash.
+func Write(d *schema.ResourceData, s Store) error {
+	vars := make(map[string]string)
+
+	keys := d.Get("key").(*schema.Set).List()
+	for _, raw := range keys {
+		key, path, sub, err := parseKey(raw)
+		if err != nil {
+			return err
+		}
+
+		value := sub["value"].(string)
+		hash := ""
+		if value != "" {
+			log.Printf("[DEBUG] Setting key '%s' to '%v'", path, value)
+			if err := s.Put(path, value); err != nil {
+				return fmt.Errorf("Failed to set key '%s': %v", path, err)
+			}
+			hash = valueHash(value)
+		} else {
+			log.Printf("[DEBUG] Getting key '%s'", path)
+			remote, ok, err := s.Get(path)
+			if err != nil {
+				return fmt.Errorf("Failed to get key '%s': %v", path, err)
+			}
+			value = attributeValue(sub, remote, ok)
+		}
+
+		vars[key] = value
+		sub["value"] = value
+		sub["hash"] = hash
+	}
+
+	d.Set("key", keys)
+	d.Set("var", vars)
+	d.Set("drifted", []string{})
+	return nil
+}
+
+// Read refreshes the values of all the keys from the store.
+//
+// If detect_drift is set, the value of every key written by the last
+// Write is also compared with the hash stored then. The names of the
+// keys that were changed outside of Terraform since then are set in
+// "drifted". The refreshed value of a drifted key differs from the
+// configured one, so the plan shows the key being set again; a drifted
+// key that was deleted has its value cleared for the same reason.
+func Read(d *schema.ResourceData, s Store) error {
+	detectDrift := d.Get("detect_drift").(bool)
+	vars := make(map[string]string)
+	drifted := make([]string, 0)
+
+	keys := d.Get("key").(*schema.Set).List()
+	for _, raw := range keys {
+		key, path, sub, err := parseKey(raw)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Refreshing value of key '%s'", path)
+		remote, ok, err := s.Get(path)
+		if err != nil {
+			return fmt.Errorf("Failed to get value for path '%s': %v", path, err)
+		}
+
+		value := attributeValue(sub, remote, ok)
+		vars[key] = value
+
+		// Only keys that are written have a hash to compare with
+		if hash, _ := sub["hash"].(string); detectDrift && hash != "" {
+			if !ok || valueHash(remote) != hash {
+				log.Printf("[WARN] Key '%s' was changed outside of Terraform", path)
+				drifted = append(drifted, key)
+				value = remote
+			}
+		}
+
+		sub["value"] = value
+	}
+
+	d.Set("key", keys)
+	d.Set("var", vars)
+	d.Set("drifted", drifted)
+	return nil
+}
+
+// Delete deletes the keys that have delete set from the store. The
+// other keys are left alone.
+func Delete(d *schema.ResourceData, s Store) error {
+	keys := d.Get("key").(*schema.Set).List()
+	for _, raw := range keys {
+		_, path, sub, err := parseKey(raw)
+		if err != nil {
+			return err
+		}
+
+		// Ignore if the key is non-managed
+		shouldDelete, ok := sub["delete"].(bool)
+		if !ok || !shouldDelete {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting key '%s'", path)
+		if err := s.Delete(path); err != nil {
+			return fmt.Errorf("Failed to delete key '%s': %v", path, err)
+		}
+	}
+
+	return nil
+}
+
+// parseKey is used to parse a key into a name, path, config or error
+func parseKey(raw interface{}) (string, string, map[string]interface{}, error) {
+	sub, ok := raw.(map[string]interface{})
+	if !ok {
+		return "", "", nil, fmt.Errorf("Failed to unroll: %#v", raw)
+	}
+
+	key, ok := sub["name"].(string)
+	if !ok {
+		return "", "", nil, fmt.Errorf("Failed to expand key '%#v'", sub)
+	}
+
+	path, ok := sub["path"].(string)
+	if !ok {
+		return "", "", nil, fmt.Errorf("Failed to get path for key '%s'", key)
+	}
+	return key, path, sub, nil
+}
+
+// attributeValue determines the value for a key, potentially
+// using a default value if provided.
+func attributeValue(sub map[string]interface{}, value string, ok bool) string {
+	// Use the value if given
+	if ok {
+		return value
+	}
+
+	// Use a default if given
+	if raw, ok := sub["default"]; ok {
+		switch def := raw.(type) {
+		case string:
+			return def
+		case bool:
+			return strconv.FormatBool(def)
+		}
+	}
+
+	// No value
+	return ""
+}
+
+// valueHash returns the hash of a value that is stored in the state.
+func valueHash(v string) string {
+	return strconv.Itoa(hashcode.String(v))
+}
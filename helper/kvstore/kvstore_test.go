@@ -0,0 +1,172 @@
+package kvstore
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestWrite(t *testing.T) {
+	s := mapStore{"app/b": "b"}
+	d := testResourceData(t, false)
+
+	if err := Write(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if s["app/a"] != "a" {
+		t.Fatalf("bad: %#v", s)
+	}
+
+	expected := map[string]interface{}{
+		"a": "a",
+		"b": "b",
+		"c": "default",
+	}
+	if actual := d.Get("var"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestRead(t *testing.T) {
+	s := mapStore{"app/b": "b"}
+	d := testResourceData(t, false)
+
+	if err := Write(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	s["app/a"] = "changed"
+	if err := Read(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if v := d.Get("var.a"); v != "changed" {
+		t.Fatalf("bad: %#v", v)
+	}
+	if v := d.Get("drifted").([]interface{}); len(v) != 0 {
+		t.Fatalf("drift detected without detect_drift: %#v", v)
+	}
+}
+
+func TestRead_detectDrift(t *testing.T) {
+	s := mapStore{"app/b": "b"}
+	d := testResourceData(t, true)
+
+	if err := Write(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Nothing changed yet
+	if err := Read(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := d.Get("drifted").([]interface{}); len(v) != 0 {
+		t.Fatalf("bad: %#v", v)
+	}
+
+	// Only keys that are written are checked for drift
+	s["app/a"] = "changed"
+	s["app/b"] = "changed"
+	if err := Read(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := d.Get("drifted").([]interface{}); !reflect.DeepEqual(v, []interface{}{"a"}) {
+		t.Fatalf("bad: %#v", v)
+	}
+	if v := testKeyValue(d, "a"); v != "changed" {
+		t.Fatalf("bad: %#v", v)
+	}
+
+	// A deleted key has its value cleared so the plan sets it again
+	delete(s, "app/a")
+	if err := Read(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := d.Get("drifted").([]interface{}); !reflect.DeepEqual(v, []interface{}{"a"}) {
+		t.Fatalf("bad: %#v", v)
+	}
+	if v := testKeyValue(d, "a"); v != "" {
+		t.Fatalf("bad: %#v", v)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	s := mapStore{"app/b": "b"}
+	d := testResourceData(t, false)
+
+	if err := Write(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := Delete(d, s); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Only the key with delete set is deleted
+	expected := mapStore{"app/b": "b"}
+	if !reflect.DeepEqual(s, expected) {
+		t.Fatalf("bad: %#v", s)
+	}
+}
+
+func testResourceData(t *testing.T, detectDrift bool) *schema.ResourceData {
+	r := &schema.Resource{Schema: Schema()}
+	d := r.Data(nil)
+
+	keys := []interface{}{
+		map[string]interface{}{
+			"name":   "a",
+			"path":   "app/a",
+			"value":  "a",
+			"delete": true,
+		},
+		map[string]interface{}{
+			"name": "b",
+			"path": "app/b",
+		},
+		map[string]interface{}{
+			"name":    "c",
+			"path":    "app/c",
+			"default": "default",
+		},
+	}
+	if err := d.Set("key", keys); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := d.Set("detect_drift", detectDrift); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return d
+}
+
+// testKeyValue returns the value of the key with the given name.
+func testKeyValue(d *schema.ResourceData, name string) string {
+	for _, raw := range d.Get("key").(*schema.Set).List() {
+		sub := raw.(map[string]interface{})
+		if sub["name"].(string) == name {
+			return sub["value"].(string)
+		}
+	}
+
+	return ""
+}
+
+// mapStore is a Store that keeps the keys in memory.
+type mapStore map[string]string
+
+func (s mapStore) Get(path string) (string, bool, error) {
+	v, ok := s[path]
+	return v, ok, nil
+}
+
+func (s mapStore) Put(path, value string) error {
+	s[path] = value
+	return nil
+}
+
+func (s mapStore) Delete(path string) error {
+	delete(s, path)
+	return nil
+}
@@ -0,0 +1,87 @@
+package resource
+
+import (
+	"log"
+	"math/rand"
+	"time"
+)
+
+// BackoffConf is the configuration for retrying a function with an
+// exponential backoff between attempts. This is meant for wrapping API
+// calls that can fail because of rate limiting, where retrying too quickly
+// only makes the problem worse.
+type BackoffConf struct {
+	// MaxRetries is the number of times the function will be retried
+	// after the first attempt fails. Zero means the function is only
+	// called once.
+	MaxRetries int
+
+	// MinDelay is the delay before the first retry. This doubles with each
+	// retry up to MaxDelay. Defaults to 500ms.
+	MinDelay time.Duration
+
+	// MaxDelay is the longest delay between two attempts. Defaults to 30s.
+	MaxDelay time.Duration
+
+	// Retryable decides if an error should be retried. If this is nil,
+	// all errors are retried. Returning a RetryError from the function
+	// always stops retrying, just like with Retry.
+	Retryable func(error) bool
+}
+
+// Retry calls the function until it succeeds, returns an error that isn't
+// retryable, or the maximum number of retries is reached. The last error
+// is returned.
+func (conf *BackoffConf) Retry(f RetryFunc) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = f()
+		if err == nil {
+			return nil
+		}
+
+		if rerr, ok := err.(RetryError); ok {
+			return rerr.Err
+		}
+
+		if conf.Retryable != nil && !conf.Retryable(err) {
+			return err
+		}
+
+		if attempt >= conf.MaxRetries {
+			return err
+		}
+
+		delay := conf.delay(attempt)
+		log.Printf(
+			"[DEBUG] Retryable error, waiting %s before retry %d/%d: %s",
+			delay, attempt+1, conf.MaxRetries, err)
+		time.Sleep(delay)
+	}
+}
+
+// delay returns the time to wait before the given retry. The delay grows
+// exponentially and has random jitter applied so that many concurrent
+// callers backing off at once don't retry in lockstep.
+func (conf *BackoffConf) delay(attempt int) time.Duration {
+	min := conf.MinDelay
+	if min <= 0 {
+		min = 500 * time.Millisecond
+	}
+	max := conf.MaxDelay
+	if max <= 0 {
+		max = 30 * time.Second
+	}
+
+	delay := min
+	for i := 0; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+
+	// Use a random delay between half and the full computed delay.
+	half := int64(delay / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
@@ -0,0 +1,117 @@
+package resource
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBackoffConfRetry(t *testing.T) {
+	t.Parallel()
+
+	tries := 0
+	conf := &BackoffConf{
+		MaxRetries: 3,
+		MinDelay:   time.Millisecond,
+	}
+	err := conf.Retry(func() error {
+		tries++
+		if tries < 3 {
+			return fmt.Errorf("error")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if tries != 3 {
+		t.Fatalf("bad: %d", tries)
+	}
+}
+
+func TestBackoffConfRetry_maxRetries(t *testing.T) {
+	t.Parallel()
+
+	tries := 0
+	conf := &BackoffConf{
+		MaxRetries: 2,
+		MinDelay:   time.Millisecond,
+	}
+	err := conf.Retry(func() error {
+		tries++
+		return fmt.Errorf("always")
+	})
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if tries != 3 {
+		t.Fatalf("bad: %d", tries)
+	}
+}
+
+func TestBackoffConfRetry_notRetryable(t *testing.T) {
+	t.Parallel()
+
+	tries := 0
+	conf := &BackoffConf{
+		MaxRetries: 5,
+		MinDelay:   time.Millisecond,
+		Retryable: func(err error) bool {
+			return err.Error() == "throttled"
+		},
+	}
+	err := conf.Retry(func() error {
+		tries++
+		if tries == 1 {
+			return fmt.Errorf("throttled")
+		}
+
+		return fmt.Errorf("fatal")
+	})
+	if err == nil || err.Error() != "fatal" {
+		t.Fatalf("bad: %#v", err)
+	}
+	if tries != 2 {
+		t.Fatalf("bad: %d", tries)
+	}
+}
+
+func TestBackoffConfRetry_error(t *testing.T) {
+	t.Parallel()
+
+	expected := fmt.Errorf("nope")
+	conf := &BackoffConf{MaxRetries: 5}
+	err := conf.Retry(func() error {
+		return RetryError{expected}
+	})
+	if err != expected {
+		t.Fatalf("bad: %#v", err)
+	}
+}
+
+func TestBackoffConfDelay(t *testing.T) {
+	conf := &BackoffConf{
+		MinDelay: 100 * time.Millisecond,
+		MaxDelay: time.Second,
+	}
+
+	cases := []struct {
+		Attempt  int
+		Min, Max time.Duration
+	}{
+		{0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{1, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond, 800 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+
+	for _, tc := range cases {
+		for i := 0; i < 20; i++ {
+			d := conf.delay(tc.Attempt)
+			if d < tc.Min || d > tc.Max {
+				t.Fatalf("%d: bad delay %s", tc.Attempt, d)
+			}
+		}
+	}
+}
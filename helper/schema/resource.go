@@ -78,6 +78,13 @@ type Resource struct {
 	Update UpdateFunc
 	Delete DeleteFunc
 	Exists ExistsFunc
+
+	// Importer is the ResourceImporter implementation for this resource.
+	// If this is nil, then this resource does not support importing. If
+	// this is non-nil, then it supports importing and ResourceImporter
+	// must be validated. The validity of ResourceImporter is verified
+	// by InternalValidate on Resource.
+	Importer *ResourceImporter
 }
 
 // See Resource documentation.
@@ -154,6 +161,31 @@ func (r *Resource) Apply(
 	return r.recordCurrentSchemaVersion(data.State()), err
 }
 
+// ReadDataApply loads the data for a data source, given a diff that
+// describes the configuration arguments and desired computed attributes.
+func (r *Resource) ReadDataApply(
+	d *terraform.InstanceDiff,
+	meta interface{}) (*terraform.InstanceState, error) {
+	// Data sources are always built completely from scratch
+	// on each read, so the source state is always nil.
+	data, err := schemaMap(r.Schema).Data(nil, d)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Read(data, meta)
+	state := data.State()
+	if state != nil && state.ID == "" {
+		// Data sources can set an ID if they want, but they aren't
+		// required to; we'll provide a placeholder if they don't,
+		// to preserve the invariant that all resources have non-empty
+		// ids.
+		state.ID = "-"
+	}
+
+	return r.recordCurrentSchemaVersion(state), err
+}
+
 // Diff returns a diff of this resource and is API compatible with the
 // ResourceProvider interface.
 func (r *Resource) Diff(
@@ -215,6 +247,25 @@ func (r *Resource) Refresh(
 	return r.recordCurrentSchemaVersion(state), err
 }
 
+// Data returns a ResourceData struct for this Resource. Each return value
+// is a separate copy and can be safely modified differently.
+//
+// The data returned from this function has no actual affect on the Resource
+// itself (including the state given to this function).
+//
+// This function is useful for unit tests and ResourceImporter functions.
+func (r *Resource) Data(s *terraform.InstanceState) *ResourceData {
+	result, err := schemaMap(r.Schema).Data(s, nil)
+	if err != nil {
+		// At the time of writing, this isn't possible (Data never returns
+		// non-nil errors). We panic to find this in the future if we have to.
+		// I don't see a reason for Data to ever return an error.
+		panic(err)
+	}
+
+	return result
+}
+
 // InternalValidate should be called to validate the structure
 // of the resource.
 //
@@ -261,9 +312,41 @@ func (r *Resource) InternalValidate(topSchemaMap schemaMap) error {
 		tsm = schemaMap(r.Schema)
 	}
 
+	// Importers can only be set on top level resources
+	if r.Importer != nil && !r.isTopLevel() {
+		return fmt.Errorf("Importer can only be set on top-level resources")
+	}
+
 	return schemaMap(r.Schema).InternalValidate(tsm)
 }
 
+// internalValidateDataSource is the InternalValidate equivalent for
+// resources that are used as data sources. Data sources are read-only,
+// so only Read may be set and none of the attributes can force a new
+// resource.
+func (r *Resource) internalValidateDataSource() error {
+	if r == nil {
+		return errors.New("data source is nil")
+	}
+	if r.Read == nil {
+		return fmt.Errorf("Read must be implemented")
+	}
+	if r.Create != nil || r.Update != nil || r.Delete != nil {
+		return fmt.Errorf("only Read may be set on a data source")
+	}
+	if r.Importer != nil {
+		return fmt.Errorf("Importer can't be set on a data source")
+	}
+	for k, v := range r.Schema {
+		if v.ForceNew {
+			return fmt.Errorf("%s: ForceNew can't be set on a data source", k)
+		}
+	}
+
+	sm := schemaMap(r.Schema)
+	return sm.InternalValidate(sm)
+}
+
 // Returns true if the resource is "top level" i.e. not a sub-resource.
 func (r *Resource) isTopLevel() bool {
 	// TODO: This is a heuristic; replace with a definitive attribute?
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 
@@ -212,6 +213,13 @@ func (d *ResourceData) SetConnInfo(v map[string]string) {
 	d.newState.Ephemeral.ConnInfo = v
 }
 
+// SetType sets the ephemeral type for the data. This is only required
+// for importing.
+func (d *ResourceData) SetType(t string) {
+	d.once.Do(d.init)
+	d.newState.Ephemeral.Type = t
+}
+
 // State returns the new InstanceState after the diff and any Set
 // calls.
 func (d *ResourceData) State() *terraform.InstanceState {
@@ -252,6 +260,9 @@ func (d *ResourceData) State() *terraform.InstanceState {
 
 	result.Attributes = mapW.Map()
 	result.Ephemeral.ConnInfo = d.ConnInfo()
+	if d.newState != nil {
+		result.Ephemeral.Type = d.newState.Ephemeral.Type
+	}
 
 	// TODO: This is hacky and we can remove this when we have a proper
 	// state writer. We should instead have a proper StateFieldWriter
@@ -270,6 +281,15 @@ func (d *ResourceData) State() *terraform.InstanceState {
 		result.Attributes["id"] = d.Id()
 	}
 
+	// Record which attributes are sensitive so that their values aren't
+	// shown when the state is displayed.
+	for k, _ := range result.Attributes {
+		if schemaMap(d.schema).sensitive(k) {
+			result.SensitiveAttributes = append(result.SensitiveAttributes, k)
+		}
+	}
+	sort.Strings(result.SensitiveAttributes)
+
 	return &result
 }
 
@@ -2836,6 +2836,60 @@ func TestResourceDataState(t *testing.T) {
 				},
 			},
 		},
+
+		// Sensitive attributes
+		{
+			Schema: map[string]*Schema{
+				"name": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+				"password": &Schema{
+					Type:      TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+				"users": &Schema{
+					Type:     TypeList,
+					Optional: true,
+					Elem: &Resource{
+						Schema: map[string]*Schema{
+							"name": &Schema{Type: TypeString},
+							"token": &Schema{
+								Type:      TypeString,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+			},
+
+			State: nil,
+
+			Diff: nil,
+
+			Set: map[string]interface{}{
+				"name":     "foo",
+				"password": "bar",
+				"users": []interface{}{
+					map[string]interface{}{
+						"name":  "baz",
+						"token": "qux",
+					},
+				},
+			},
+
+			Result: &terraform.InstanceState{
+				Attributes: map[string]string{
+					"name":          "foo",
+					"password":      "bar",
+					"users.#":       "1",
+					"users.0.name":  "baz",
+					"users.0.token": "qux",
+				},
+				SensitiveAttributes: []string{"password", "users.0.token"},
+			},
+		},
 	}
 
 	for i, tc := range cases {
@@ -139,6 +139,11 @@ type Schema struct {
 	//
 	// ValidateFunc currently only works for primitive types.
 	ValidateFunc SchemaValidateFunc
+
+	// Sensitive marks the value as sensitive, such as a password or a
+	// private key. The value is still stored in the state, but it isn't
+	// shown in the plan output or in the logs.
+	Sensitive bool
 }
 
 // SchemaDefaultFunc is a function called to return a default value for
@@ -359,10 +364,16 @@ func (m schemaMap) Diff(
 		result = result2
 	}
 
-	// Remove any nil diffs just to keep things clean
+	// Remove any nil diffs just to keep things clean, and mark the
+	// diffs of sensitive values so they aren't shown.
 	for k, v := range result.Attributes {
 		if v == nil {
 			delete(result.Attributes, k)
+			continue
+		}
+
+		if m.sensitive(k) {
+			v.Sensitive = true
 		}
 	}
 
@@ -378,6 +389,18 @@ func (m schemaMap) Diff(
 	return result, nil
 }
 
+// sensitive returns whether the value at the given flattened key, or
+// any value it is part of, is marked as sensitive.
+func (m schemaMap) sensitive(k string) bool {
+	for _, s := range addrToSchema(strings.Split(k, "."), m) {
+		if s.Sensitive {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Input implements the terraform.ResourceProvider method by asking
 // for input for required configuration keys that don't have a value.
 func (m schemaMap) Input(
@@ -2416,6 +2416,49 @@ func TestSchemaMap_Diff(t *testing.T) {
 
 			Err: false,
 		},
+
+		// #61 - Sensitive values
+		{
+			Schema: map[string]*Schema{
+				"password": &Schema{
+					Type:      TypeString,
+					Optional:  true,
+					Sensitive: true,
+				},
+				"name": &Schema{
+					Type:     TypeString,
+					Optional: true,
+				},
+			},
+
+			State: &terraform.InstanceState{
+				Attributes: map[string]string{
+					"password": "foo",
+					"name":     "bar",
+				},
+			},
+
+			Config: map[string]interface{}{
+				"password": "baz",
+				"name":     "qux",
+			},
+
+			Diff: &terraform.InstanceDiff{
+				Attributes: map[string]*terraform.ResourceAttrDiff{
+					"password": &terraform.ResourceAttrDiff{
+						Old:       "foo",
+						New:       "baz",
+						Sensitive: true,
+					},
+					"name": &terraform.ResourceAttrDiff{
+						Old: "bar",
+						New: "qux",
+					},
+				},
+			},
+
+			Err: false,
+		},
 	}
 
 	for i, tc := range cases {
@@ -142,6 +142,7 @@ func wrappedMain() int {
 	// Initialize the TFConfig settings for the commands...
 	ContextOpts.Providers = config.ProviderFactories()
 	ContextOpts.Provisioners = config.ProvisionerFactories()
+	ProviderResolver = config.ProviderResolver()
 
 	exitCode, err := cli.Run()
 	if err != nil {
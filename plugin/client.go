@@ -16,6 +16,7 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/hashicorp/go-version"
 	tfrpc "github.com/hashicorp/terraform/rpc"
 )
 
@@ -36,6 +37,7 @@ type Client struct {
 	doneLogging chan struct{}
 	l           sync.Mutex
 	address     net.Addr
+	version     string
 	client      *tfrpc.Client
 }
 
@@ -65,6 +67,12 @@ type ClientConfig struct {
 	// If non-nil, then the stderr of the client will be written to here
 	// (as well as the log).
 	Stderr io.Writer
+
+	// Version is the version the plugin is expected to be. If this is
+	// set, the plugin must report an equal version when it starts or
+	// Start will return an error. Versions are compared semantically, so
+	// "1.2" is equal to "1.2.0".
+	Version string
 }
 
 // This makes sure all the managed subprocesses are killed and properly
@@ -145,6 +153,15 @@ func (c *Client) Client() (*tfrpc.Client, error) {
 	return c.client, nil
 }
 
+// Version returns the version the plugin reported when it started, or
+// an empty string if it didn't report one. This is only available after
+// calling Start.
+func (c *Client) Version() string {
+	c.l.Lock()
+	defer c.l.Unlock()
+	return c.version
+}
+
 // Tells whether or not the underlying process has exited.
 func (c *Client) Exited() bool {
 	c.l.Lock()
@@ -291,7 +308,7 @@ func (c *Client) Start() (addr net.Addr, err error) {
 		// Trim the line and split by "|" in order to get the parts of
 		// the output.
 		line := strings.TrimSpace(string(lineBytes))
-		parts := strings.SplitN(line, "|", 3)
+		parts := strings.SplitN(line, "|", 4)
 		if len(parts) < 3 {
 			err = fmt.Errorf("Unrecognized remote plugin message: %s", line)
 			return
@@ -304,6 +321,20 @@ func (c *Client) Start() (addr net.Addr, err error) {
 			return
 		}
 
+		// Test the plugin version, if it reported one. Older plugins
+		// don't send their version at all.
+		var reported string
+		if len(parts) > 3 {
+			reported = parts[3]
+		}
+		if c.config.Version != "" && !versionEqual(reported, c.config.Version) {
+			err = fmt.Errorf("Unexpected plugin version for %s. "+
+				"Plugin version: %q, expected: %q",
+				cmd.Path, reported, c.config.Version)
+			return
+		}
+		c.version = reported
+
 		switch parts[1] {
 		case "tcp":
 			addr, err = net.ResolveTCPAddr("tcp", parts[2])
@@ -337,3 +368,19 @@ func (c *Client) logStderr(r io.Reader) {
 	// Flag that we've completed logging for others
 	close(c.doneLogging)
 }
+
+// versionEqual returns whether a and b are the same version, such as
+// "1.2" and "1.2.0". Strings that aren't valid versions are never equal.
+func versionEqual(a, b string) bool {
+	va, err := version.NewVersion(a)
+	if err != nil {
+		return false
+	}
+
+	vb, err := version.NewVersion(b)
+	if err != nil {
+		return false
+	}
+
+	return va.Equal(vb)
+}
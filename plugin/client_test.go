@@ -56,6 +56,66 @@ func TestClientStart_badVersion(t *testing.T) {
 	}
 }
 
+func TestClientStart_pluginVersion(t *testing.T) {
+	c := NewClient(&ClientConfig{
+		Cmd:     helperProcess("mock-version"),
+		Version: "1.2.0",
+	})
+	defer c.Kill()
+
+	if _, err := c.Start(); err != nil {
+		t.Fatalf("err should be nil, got %s", err)
+	}
+
+	if v := c.Version(); v != "1.2.0" {
+		t.Fatalf("bad: %q", v)
+	}
+}
+
+func TestClientStart_pluginVersionShort(t *testing.T) {
+	c := NewClient(&ClientConfig{
+		Cmd:     helperProcess("mock-version"),
+		Version: "1.2",
+	})
+	defer c.Kill()
+
+	if _, err := c.Start(); err != nil {
+		t.Fatalf("err should be nil, got %s", err)
+	}
+}
+
+func TestClientStart_badPluginVersion(t *testing.T) {
+	config := &ClientConfig{
+		Cmd:          helperProcess("mock-version"),
+		StartTimeout: 50 * time.Millisecond,
+		Version:      "1.3.0",
+	}
+
+	c := NewClient(config)
+	defer c.Kill()
+
+	_, err := c.Start()
+	if err == nil {
+		t.Fatal("err should not be nil")
+	}
+}
+
+func TestClientStart_noPluginVersion(t *testing.T) {
+	config := &ClientConfig{
+		Cmd:          helperProcess("mock"),
+		StartTimeout: 50 * time.Millisecond,
+		Version:      "1.2.0",
+	}
+
+	c := NewClient(config)
+	defer c.Kill()
+
+	_, err := c.Start()
+	if err == nil {
+		t.Fatal("err should not be nil")
+	}
+}
+
 func TestClient_Start_Timeout(t *testing.T) {
 	config := &ClientConfig{
 		Cmd:          helperProcess("start-timeout"),
@@ -66,6 +66,9 @@ func TestHelperProcess(*testing.T) {
 		})
 	case "invalid-rpc-address":
 		fmt.Println("lolinvalid")
+	case "mock-version":
+		fmt.Printf("%s|tcp|:1234|1.2.0\n", APIVersion)
+		<-make(chan int)
 	case "mock":
 		fmt.Printf("%s|tcp|:1234\n", APIVersion)
 		<-make(chan int)
@@ -30,6 +30,11 @@ const MagicCookieValue = "d602bf8f470bc67ca7faa0386276bbdd4330efaf76d1a219cb4d69
 type ServeOpts struct {
 	ProviderFunc    tfrpc.ProviderFunc
 	ProvisionerFunc tfrpc.ProvisionerFunc
+
+	// Version is the version of the plugin itself, such as "1.2.0". If
+	// this is set, it is sent to Terraform core as part of the handshake
+	// so that it can verify it is running the plugin version it expects.
+	Version string
 }
 
 // Serve serves the plugins given by ServeOpts.
@@ -64,10 +69,14 @@ func Serve(opts *ServeOpts) {
 	// core can bring it up.
 	log.Printf("Plugin address: %s %s\n",
 		listener.Addr().Network(), listener.Addr().String())
-	fmt.Printf("%s|%s|%s\n",
+	handshake := fmt.Sprintf("%s|%s|%s",
 		APIVersion,
 		listener.Addr().Network(),
 		listener.Addr().String())
+	if opts.Version != "" {
+		handshake += "|" + opts.Version
+	}
+	fmt.Println(handshake)
 	os.Stdout.Sync()
 
 	// Eat the interrupts
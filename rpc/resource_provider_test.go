@@ -266,6 +266,41 @@ func TestResourceProvider_refresh(t *testing.T) {
 	}
 }
 
+func TestResourceProvider_importState(t *testing.T) {
+	p := new(terraform.MockResourceProvider)
+	client, server := testClientServer(t)
+	name, err := Register(server, p)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	provider := &ResourceProvider{Client: client, Name: name}
+
+	p.ImportStateReturn = []*terraform.InstanceState{
+		&terraform.InstanceState{
+			ID: "bob",
+		},
+	}
+
+	// ImportState
+	info := &terraform.InstanceInfo{}
+	states, err := provider.ImportState(info, "foo")
+	if !p.ImportStateCalled {
+		t.Fatal("ImportState should be called")
+	}
+	if !reflect.DeepEqual(p.ImportStateInfo, info) {
+		t.Fatalf("bad: %#v", p.ImportStateInfo)
+	}
+	if p.ImportStateID != "foo" {
+		t.Fatalf("bad: %#v", p.ImportStateID)
+	}
+	if err != nil {
+		t.Fatalf("bad: %#v", err)
+	}
+	if len(states) != 1 || states[0].ID != "bob" {
+		t.Fatalf("bad: %#v", states)
+	}
+}
+
 func TestResourceProvider_resources(t *testing.T) {
 	p := new(terraform.MockResourceProvider)
 	client, server := testClientServer(t)
@@ -292,6 +327,101 @@ func TestResourceProvider_resources(t *testing.T) {
 	}
 }
 
+func TestResourceProvider_readDataDiff(t *testing.T) {
+	p := new(terraform.MockResourceProvider)
+	client, server := testClientServer(t)
+	name, err := Register(server, p)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	provider := &ResourceProvider{Client: client, Name: name}
+
+	p.ReadDataDiffReturn = &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"foo": &terraform.ResourceAttrDiff{
+				Old: "",
+				New: "bar",
+			},
+		},
+	}
+
+	// ReadDataDiff
+	info := &terraform.InstanceInfo{}
+	config := &terraform.ResourceConfig{
+		Raw: map[string]interface{}{"foo": "bar"},
+	}
+	diff, err := provider.ReadDataDiff(info, config)
+	if !p.ReadDataDiffCalled {
+		t.Fatal("ReadDataDiff should be called")
+	}
+	if !reflect.DeepEqual(p.ReadDataDiffDesired, config) {
+		t.Fatalf("bad: %#v", p.ReadDataDiffDesired)
+	}
+	if err != nil {
+		t.Fatalf("bad: %#v", err)
+	}
+	if !reflect.DeepEqual(p.ReadDataDiffReturn, diff) {
+		t.Fatalf("bad: %#v", diff)
+	}
+}
+
+func TestResourceProvider_readDataApply(t *testing.T) {
+	p := new(terraform.MockResourceProvider)
+	client, server := testClientServer(t)
+	name, err := Register(server, p)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	provider := &ResourceProvider{Client: client, Name: name}
+
+	p.ReadDataApplyReturn = &terraform.InstanceState{
+		ID: "bob",
+	}
+
+	// ReadDataApply
+	info := &terraform.InstanceInfo{}
+	diff := &terraform.InstanceDiff{}
+	newState, err := provider.ReadDataApply(info, diff)
+	if !p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should be called")
+	}
+	if !reflect.DeepEqual(p.ReadDataApplyDiff, diff) {
+		t.Fatalf("bad: %#v", p.ReadDataApplyDiff)
+	}
+	if err != nil {
+		t.Fatalf("bad: %#v", err)
+	}
+	if !reflect.DeepEqual(p.ReadDataApplyReturn, newState) {
+		t.Fatalf("bad: %#v", newState)
+	}
+}
+
+func TestResourceProvider_dataSources(t *testing.T) {
+	p := new(terraform.MockResourceProvider)
+	client, server := testClientServer(t)
+	name, err := Register(server, p)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	provider := &ResourceProvider{Client: client, Name: name}
+
+	expected := []terraform.DataSource{
+		{"foo"},
+		{"bar"},
+	}
+
+	p.DataSourcesReturn = expected
+
+	// DataSources
+	result := provider.DataSources()
+	if !p.DataSourcesCalled {
+		t.Fatal("DataSources should be called")
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("bad: %#v", result)
+	}
+}
+
 func TestResourceProvider_validate(t *testing.T) {
 	p := new(terraform.MockResourceProvider)
 	client, server := testClientServer(t)
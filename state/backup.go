@@ -43,6 +43,22 @@ func (s *BackupState) PersistState() error {
 	return s.Real.PersistState()
 }
 
+func (s *BackupState) Lock(reason string) error {
+	if l, ok := s.Real.(Locker); ok {
+		return l.Lock(reason)
+	}
+
+	return nil
+}
+
+func (s *BackupState) Unlock() error {
+	if l, ok := s.Real.(Locker); ok {
+		return l.Unlock()
+	}
+
+	return nil
+}
+
 func (s *BackupState) backup() error {
 	state := s.Real.State()
 	if state == nil {
@@ -131,6 +131,27 @@ func (s *CacheState) PersistState() error {
 	return s.Durable.PersistState()
 }
 
+// Lock locks the durable storage, if it supports locking. The local cache
+// is only ever used by this process, so it doesn't need a lock.
+//
+// Locker impl.
+func (s *CacheState) Lock(reason string) error {
+	if l, ok := s.Durable.(Locker); ok {
+		return l.Lock(reason)
+	}
+
+	return nil
+}
+
+// Locker impl.
+func (s *CacheState) Unlock() error {
+	if l, ok := s.Durable.(Locker); ok {
+		return l.Unlock()
+	}
+
+	return nil
+}
+
 // CacheStateCache is the meta-interface that must be implemented for
 // the cache for the CacheState.
 type CacheStateCache interface {
@@ -55,4 +55,5 @@ func TestCacheState_impl(t *testing.T) {
 	var _ StateWriter = new(CacheState)
 	var _ StatePersister = new(CacheState)
 	var _ StateRefresher = new(CacheState)
+	var _ Locker = new(CacheState)
 }
@@ -1,12 +1,19 @@
 package state
 
 import (
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/hashicorp/terraform/terraform"
 )
 
+// errLocked is returned by lockFile if the lock is held elsewhere.
+var errLocked = errors.New("state is locked")
+
 // LocalState manages a state storage that is local to the filesystem.
 type LocalState struct {
 	// Path is the path to read the state from. PathOut is the path to
@@ -18,6 +25,7 @@ type LocalState struct {
 	state     *terraform.State
 	readState *terraform.State
 	written   bool
+	lock      *os.File
 }
 
 // SetState will force a specific state in-memory for this local state.
@@ -113,3 +121,60 @@ func (s *LocalState) RefreshState() error {
 	s.readState = state
 	return nil
 }
+
+// Lock takes an exclusive lock on the state by locking a hidden lock file
+// alongside Path. The reason is written into the lock file so that others
+// that fail to acquire the lock can report who holds it.
+//
+// Locker impl.
+func (s *LocalState) Lock(reason string) error {
+	if s.lock != nil {
+		return fmt.Errorf("state %s is already locked by this process", s.Path)
+	}
+
+	path := s.lockPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	f, err := lockFile(path)
+	if err == errLocked {
+		info, rerr := ioutil.ReadFile(path)
+		if rerr != nil || len(info) == 0 {
+			return fmt.Errorf("state %s is locked", s.Path)
+		}
+
+		return fmt.Errorf("state %s is locked: %s", s.Path, info)
+	}
+	if err != nil {
+		return fmt.Errorf("error locking state %s: %s", s.Path, err)
+	}
+
+	info := fmt.Sprintf("%s (pid %d, %s)",
+		reason, os.Getpid(), time.Now().UTC().Format(time.RFC3339))
+	if err := f.Truncate(0); err == nil {
+		f.WriteAt([]byte(info), 0)
+	}
+
+	s.lock = f
+	return nil
+}
+
+// Unlock releases the lock taken by Lock and removes the lock file.
+//
+// Locker impl.
+func (s *LocalState) Unlock() error {
+	if s.lock == nil {
+		return nil
+	}
+
+	f := s.lock
+	s.lock = nil
+	return unlockFile(f)
+}
+
+// lockPath returns the path of the lock file for this state.
+func (s *LocalState) lockPath() string {
+	dir, file := filepath.Split(s.Path)
+	return filepath.Join(dir, fmt.Sprintf(".%s.lock", file))
+}
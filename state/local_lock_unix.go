@@ -0,0 +1,53 @@
+// +build darwin freebsd linux netbsd openbsd
+
+package state
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile opens the file at path and takes an exclusive, non-blocking
+// flock on it.
+func lockFile(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+		if err == syscall.EWOULDBLOCK {
+			return nil, errLocked
+		}
+
+		return nil, err
+	}
+
+	// The holder removes the file when unlocking, so we may have locked
+	// a file that no longer exists at path. In that case someone else
+	// may already hold a lock on the new file, so treat it as locked.
+	fi, ferr := f.Stat()
+	pfi, perr := os.Stat(path)
+	if ferr != nil || perr != nil || !os.SameFile(fi, pfi) {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+		return nil, errLocked
+	}
+
+	return f, nil
+}
+
+// unlockFile removes the lock file and releases the lock taken with
+// lockFile. The file is removed while the lock is still held so nobody
+// else can lock it in between.
+func unlockFile(f *os.File) error {
+	os.Remove(f.Name())
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_UN); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,52 @@
+// +build windows
+
+package state
+
+import (
+	"os"
+	"syscall"
+)
+
+// ERROR_SHARING_VIOLATION is returned by CreateFile when another handle
+// to the file is open without sharing.
+const errorSharingViolation syscall.Errno = 32
+
+// lockFile opens the file at path with no sharing allowed. As long as the
+// handle stays open no other process can open the file, which makes it
+// act as an exclusive lock.
+func lockFile(path string) (*os.File, error) {
+	name, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := syscall.CreateFile(
+		name,
+		syscall.GENERIC_READ|syscall.GENERIC_WRITE,
+		0,
+		nil,
+		syscall.OPEN_ALWAYS,
+		syscall.FILE_ATTRIBUTE_NORMAL,
+		0)
+	if err != nil {
+		if err == errorSharingViolation {
+			return nil, errLocked
+		}
+
+		return nil, err
+	}
+
+	return os.NewFile(uintptr(h), path), nil
+}
+
+// unlockFile releases a lock taken with lockFile by closing the file, and
+// then removes it. The file can't be removed while our handle is open, and
+// if someone else opens it first the removal fails harmlessly.
+func unlockFile(f *os.File) error {
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	os.Remove(f.Name())
+	return nil
+}
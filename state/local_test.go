@@ -45,6 +45,35 @@ func TestLocalState_impl(t *testing.T) {
 	var _ StateWriter = new(LocalState)
 	var _ StatePersister = new(LocalState)
 	var _ StateRefresher = new(LocalState)
+	var _ Locker = new(LocalState)
+}
+
+func TestLocalState_lock(t *testing.T) {
+	ls := testLocalState(t)
+	defer os.Remove(ls.Path)
+	defer os.Remove(ls.lockPath())
+
+	if err := ls.Lock("test"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// A second state for the same path must not be able to lock
+	other := &LocalState{Path: ls.Path}
+	if err := other.Lock("other"); err == nil {
+		t.Fatal("expected lock error")
+	}
+
+	if err := ls.Unlock(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Now that it is released the other state can take the lock
+	if err := other.Lock("other"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := other.Unlock(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
 }
 
 func testLocalState(t *testing.T) *LocalState {
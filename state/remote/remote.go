@@ -13,6 +13,15 @@ type Client interface {
 	Delete() error
 }
 
+// ClientLocker is an optional interface that can be implemented by a
+// Client to support locking the remote state against concurrent use.
+type ClientLocker interface {
+	Client
+
+	Lock(reason string) error
+	Unlock() error
+}
+
 // Payload is the return value from the remote state storage.
 type Payload struct {
 	MD5  []byte
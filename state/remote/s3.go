@@ -2,17 +2,22 @@ package remote
 
 import (
 	"bytes"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/go-cleanhttp"
 )
@@ -48,11 +53,18 @@ func s3Factory(conf map[string]string) (Client, error) {
 		serverSideEncryption = v
 	}
 
+	kmsKeyID := conf["kms_key_id"]
+	if kmsKeyID != "" && !serverSideEncryption {
+		return nil, fmt.Errorf("'kms_key_id' requires 'encrypt' to be enabled")
+	}
+
 	acl := ""
 	if raw, ok := conf["acl"]; ok {
 		acl = raw
 	}
 
+	lockTable := conf["lock_table"]
+
 	accessKeyId := conf["access_key"]
 	secretAccessKey := conf["secret_key"]
 
@@ -82,21 +94,36 @@ func s3Factory(conf map[string]string) (Client, error) {
 	sess := session.New(awsConfig)
 	nativeClient := s3.New(sess)
 
+	var dynClient dynamodbiface.DynamoDBAPI
+	if lockTable != "" {
+		dynClient = dynamodb.New(sess)
+	}
+
 	return &S3Client{
 		nativeClient:         nativeClient,
+		dynClient:            dynClient,
 		bucketName:           bucketName,
 		keyName:              keyName,
 		serverSideEncryption: serverSideEncryption,
+		kmsKeyID:             kmsKeyID,
 		acl:                  acl,
+		lockTable:            lockTable,
 	}, nil
 }
 
 type S3Client struct {
 	nativeClient         *s3.S3
+	dynClient            dynamodbiface.DynamoDBAPI
 	bucketName           string
 	keyName              string
 	serverSideEncryption bool
+	kmsKeyID             string
 	acl                  string
+	lockTable            string
+
+	// lockID identifies the lock item written by Lock, so that Unlock
+	// only ever removes a lock that this client holds.
+	lockID string
 }
 
 func (c *S3Client) Get() (*Payload, error) {
@@ -149,7 +176,12 @@ func (c *S3Client) Put(data []byte) error {
 	}
 
 	if c.serverSideEncryption {
-		i.ServerSideEncryption = aws.String("AES256")
+		if c.kmsKeyID != "" {
+			i.ServerSideEncryption = aws.String("aws:kms")
+			i.SSEKMSKeyId = aws.String(c.kmsKeyID)
+		} else {
+			i.ServerSideEncryption = aws.String("AES256")
+		}
 	}
 
 	if c.acl != "" {
@@ -173,3 +205,124 @@ func (c *S3Client) Delete() error {
 
 	return err
 }
+
+// Lock acquires the lock for this state by writing an item to the
+// DynamoDB lock table. The write is conditional on no item existing for
+// this state, so only one client can hold the lock at a time. If no
+// lock table was configured, this is a no-op.
+func (c *S3Client) Lock(reason string) error {
+	if c.lockTable == "" {
+		return nil
+	}
+
+	id, err := s3LockID()
+	if err != nil {
+		return fmt.Errorf("Failed to generate lock ID: %s", err)
+	}
+
+	info := fmt.Sprintf("%s (%s)", reason, time.Now().UTC().Format(time.RFC3339))
+	if host, err := os.Hostname(); err == nil {
+		info = fmt.Sprintf("%s on %s", info, host)
+	}
+
+	_, err = c.dynClient.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(c.lockTable),
+		Item: map[string]*dynamodb.AttributeValue{
+			"LockID": &dynamodb.AttributeValue{S: aws.String(c.lockPath())},
+			"Owner":  &dynamodb.AttributeValue{S: aws.String(id)},
+			"Info":   &dynamodb.AttributeValue{S: aws.String(info)},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(LockID)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ConditionalCheckFailedException" {
+			return fmt.Errorf(
+				"State %s is locked: %s", c.lockPath(), c.lockInfo())
+		}
+
+		return fmt.Errorf("Failed to lock state: %s", err)
+	}
+
+	c.lockID = id
+	return nil
+}
+
+// Unlock releases the lock acquired by Lock. The delete is conditional
+// on the lock item still being the one this client wrote, so a lock
+// held by someone else is never removed. If no lock table was
+// configured, this is a no-op.
+func (c *S3Client) Unlock() error {
+	if c.lockTable == "" {
+		return nil
+	}
+
+	if c.lockID == "" {
+		return fmt.Errorf(
+			"Failed to unlock state %s: lock is not held by this client",
+			c.lockPath())
+	}
+
+	_, err := c.dynClient.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName: aws.String(c.lockTable),
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockID": &dynamodb.AttributeValue{S: aws.String(c.lockPath())},
+		},
+		// Owner is a reserved word in DynamoDB expressions
+		ConditionExpression: aws.String("#owner = :owner"),
+		ExpressionAttributeNames: map[string]*string{
+			"#owner": aws.String("Owner"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":owner": &dynamodb.AttributeValue{S: aws.String(c.lockID)},
+		},
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ConditionalCheckFailedException" {
+			return fmt.Errorf(
+				"Failed to unlock state %s: lock is held by another client: %s",
+				c.lockPath(), c.lockInfo())
+		}
+
+		return fmt.Errorf("Failed to unlock state: %s", err)
+	}
+
+	c.lockID = ""
+	return nil
+}
+
+// s3LockID returns a random ID to identify the lock item written by
+// a single call to Lock.
+func s3LockID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// lockPath is the ID of the lock item for this state in the lock table.
+func (c *S3Client) lockPath() string {
+	return fmt.Sprintf("%s/%s", c.bucketName, c.keyName)
+}
+
+// lockInfo returns the description stored with the currently held lock,
+// for use in error messages.
+func (c *S3Client) lockInfo() string {
+	resp, err := c.dynClient.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String(c.lockTable),
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockID": &dynamodb.AttributeValue{S: aws.String(c.lockPath())},
+		},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Sprintf("unable to read lock info: %s", err)
+	}
+
+	if v, ok := resp.Item["Info"]; ok && v.S != nil {
+		return *v.S
+	}
+
+	return "no lock info available"
+}
@@ -6,11 +6,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
 func TestS3Client_impl(t *testing.T) {
 	var _ Client = new(S3Client)
+	var _ ClientLocker = new(S3Client)
 }
 
 func TestS3Factory(t *testing.T) {
@@ -52,6 +56,9 @@ func TestS3Factory(t *testing.T) {
 	if s3Client.keyName != "bar" {
 		t.Fatalf("Incorrect keyName was populated")
 	}
+	if s3Client.dynClient != nil {
+		t.Fatalf("DynamoDB client should not be created without a lock table")
+	}
 
 	credentials, err := s3Client.nativeClient.Config.Credentials.Get()
 	if err != nil {
@@ -65,6 +72,110 @@ func TestS3Factory(t *testing.T) {
 	}
 }
 
+func TestS3Factory_kmsKeyId(t *testing.T) {
+	config := map[string]string{
+		"region":     "us-west-1",
+		"bucket":     "foo",
+		"key":        "bar",
+		"kms_key_id": "arn:aws:kms:us-west-1:123456789012:key/abcd",
+		"access_key": "bazkey",
+		"secret_key": "bazsecret",
+	}
+
+	// A KMS key without encryption enabled is an error
+	if _, err := s3Factory(config); err == nil {
+		t.Fatalf("kms_key_id without encrypt should be error")
+	}
+
+	config["encrypt"] = "1"
+	client, err := s3Factory(config)
+	if err != nil {
+		t.Fatalf("Error for valid config: %s", err)
+	}
+
+	s3Client := client.(*S3Client)
+	if s3Client.kmsKeyID != config["kms_key_id"] {
+		t.Fatalf("Incorrect kmsKeyID was populated")
+	}
+}
+
+func TestS3Factory_lockTable(t *testing.T) {
+	config := map[string]string{
+		"region":     "us-west-1",
+		"bucket":     "foo",
+		"key":        "bar",
+		"lock_table": "terraform-locks",
+		"access_key": "bazkey",
+		"secret_key": "bazsecret",
+	}
+
+	client, err := s3Factory(config)
+	if err != nil {
+		t.Fatalf("Error for valid config: %s", err)
+	}
+
+	s3Client := client.(*S3Client)
+	if s3Client.lockTable != "terraform-locks" {
+		t.Fatalf("Incorrect lockTable was populated")
+	}
+	if s3Client.dynClient == nil {
+		t.Fatalf("DynamoDB client was not created")
+	}
+	if s3Client.lockPath() != "foo/bar" {
+		t.Fatalf("Incorrect lock path: %s", s3Client.lockPath())
+	}
+}
+
+func TestS3Client_lockOwner(t *testing.T) {
+	table := &mockDynamoDBLockTable{
+		items: make(map[string]map[string]*dynamodb.AttributeValue),
+	}
+	newClient := func() *S3Client {
+		return &S3Client{
+			dynClient:  table,
+			bucketName: "foo",
+			keyName:    "bar",
+			lockTable:  "terraform-locks",
+		}
+	}
+
+	a := newClient()
+	b := newClient()
+
+	if err := a.Lock("test"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if err := b.Lock("test"); err == nil {
+		t.Fatal("second lock should fail")
+	}
+
+	// A client that never acquired the lock can't release it
+	if err := b.Unlock(); err == nil {
+		t.Fatal("unlock without holding the lock should fail")
+	}
+
+	// Neither can a client that thinks it holds a different lock
+	b.lockID = "not-the-owner"
+	if err := b.Unlock(); err == nil {
+		t.Fatal("unlock by a non-owner should fail")
+	}
+	if _, ok := table.items["foo/bar"]; !ok {
+		t.Fatal("lock should still be held")
+	}
+
+	if err := a.Unlock(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, ok := table.items["foo/bar"]; ok {
+		t.Fatal("lock should be released")
+	}
+
+	b.lockID = ""
+	if err := b.Lock("test"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
 func TestS3Client(t *testing.T) {
 	// This test creates a bucket in S3 and populates it.
 	// It may incur costs, so it will only run if AWS credential environment
@@ -129,3 +240,45 @@ func TestS3Client(t *testing.T) {
 
 	testClient(t, client)
 }
+
+// mockDynamoDBLockTable is an in-memory lock table that understands the
+// conditional writes S3Client uses for locking.
+type mockDynamoDBLockTable struct {
+	dynamodbiface.DynamoDBAPI
+
+	items map[string]map[string]*dynamodb.AttributeValue
+}
+
+func (m *mockDynamoDBLockTable) PutItem(
+	input *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	id := *input.Item["LockID"].S
+	if _, ok := m.items[id]; ok && input.ConditionExpression != nil {
+		return nil, awserr.New(
+			"ConditionalCheckFailedException", "lock exists", nil)
+	}
+
+	m.items[id] = input.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (m *mockDynamoDBLockTable) DeleteItem(
+	input *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	id := *input.Key["LockID"].S
+	item, ok := m.items[id]
+	if input.ConditionExpression != nil {
+		attr := *input.ExpressionAttributeNames["#owner"]
+		owner := *input.ExpressionAttributeValues[":owner"].S
+		if !ok || item[attr] == nil || *item[attr].S != owner {
+			return nil, awserr.New(
+				"ConditionalCheckFailedException", "owner mismatch", nil)
+		}
+	}
+
+	delete(m.items, id)
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func (m *mockDynamoDBLockTable) GetItem(
+	input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	return &dynamodb.GetItemOutput{Item: m.items[*input.Key["LockID"].S]}, nil
+}
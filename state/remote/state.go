@@ -58,3 +58,24 @@ func (s *State) PersistState() error {
 
 	return s.Client.Put(buf.Bytes())
 }
+
+// Lock locks the remote state if the client supports locking. Clients
+// that don't implement ClientLocker are treated as always unlocked.
+//
+// Locker impl.
+func (s *State) Lock(reason string) error {
+	if c, ok := s.Client.(ClientLocker); ok {
+		return c.Lock(reason)
+	}
+
+	return nil
+}
+
+// Locker impl.
+func (s *State) Unlock() error {
+	if c, ok := s.Client.(ClientLocker); ok {
+		return c.Unlock()
+	}
+
+	return nil
+}
@@ -40,3 +40,13 @@ type StateRefresher interface {
 type StatePersister interface {
 	PersistState() error
 }
+
+// Locker is implemented by states that can be locked to prevent concurrent
+// use by multiple Terraform processes. Lock must return an error if the
+// lock is already held elsewhere. The reason is a short description of
+// the operation holding the lock, which is reported to anyone who fails
+// to acquire it.
+type Locker interface {
+	Lock(reason string) error
+	Unlock() error
+}
@@ -37,6 +37,7 @@ const (
 type ContextOpts struct {
 	Destroy      bool
 	Diff         *Diff
+	Environment  string
 	Hooks        []Hook
 	Module       *module.Tree
 	Parallelism  int
@@ -56,6 +57,7 @@ type Context struct {
 	destroy      bool
 	diff         *Diff
 	diffLock     sync.RWMutex
+	environment  string
 	hooks        []Hook
 	module       *module.Tree
 	providers    map[string]ResourceProviderFactory
@@ -123,6 +125,7 @@ func NewContext(opts *ContextOpts) *Context {
 	return &Context{
 		destroy:      opts.Destroy,
 		diff:         opts.Diff,
+		environment:  opts.Environment,
 		hooks:        hooks,
 		module:       opts.Module,
 		providers:    opts.Providers,
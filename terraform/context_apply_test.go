@@ -410,6 +410,39 @@ func TestContext2Apply_minimal(t *testing.T) {
 	}
 }
 
+func TestContext2Apply_dataBasic(t *testing.T) {
+	m := testModule(t, "apply-data-basic")
+	p := testProvider("null")
+	p.ReadDataApplyReturn = &InstanceState{ID: "yo"}
+
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"null": testProviderFuncFixed(p),
+		},
+	})
+
+	if p, err := ctx.Plan(); err != nil {
+		t.Fatalf("err: %s", err)
+	} else {
+		t.Logf(p.String())
+	}
+
+	state, err := ctx.Apply()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should be called")
+	}
+
+	actual := strings.TrimSpace(state.String())
+	expected := strings.TrimSpace(testTerraformApplyDataBasicStr)
+	if actual != expected {
+		t.Fatalf("bad: \n%s", actual)
+	}
+}
+
 func TestContext2Apply_badDiff(t *testing.T) {
 	m := testModule(t, "apply-good")
 	p := testProvider("aws")
@@ -2830,6 +2863,39 @@ func TestContext2Apply_output(t *testing.T) {
 	}
 }
 
+func TestContext2Apply_outputSensitive(t *testing.T) {
+	m := testModule(t, "apply-output-sensitive")
+	p := testProvider("aws")
+	p.ApplyFn = testApplyFn
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	if _, err := ctx.Plan(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	state, err := ctx.Apply()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	root := state.RootModule()
+	if v := root.Outputs["secret"]; v != "2" {
+		t.Fatalf("bad: %#v", v)
+	}
+	if root.OutputSensitive("foo_num") {
+		t.Fatal("foo_num should not be sensitive")
+	}
+	if !root.OutputSensitive("secret") {
+		t.Fatal("secret should be sensitive")
+	}
+}
+
 func TestContext2Apply_outputInvalid(t *testing.T) {
 	m := testModule(t, "apply-output-invalid")
 	p := testProvider("aws")
@@ -0,0 +1,51 @@
+package terraform
+
+// ImportOpts are used as the configuration for Import.
+type ImportOpts struct {
+	// Targets are the targets to import
+	Targets []*ImportTarget
+}
+
+// ImportTarget is a single resource to import.
+type ImportTarget struct {
+	// Addr is the full resource address of the resource to import.
+	// Example: "aws_instance.foo"
+	Addr string
+
+	// ID is the ID of the resource to import. This is resource-specific.
+	ID string
+}
+
+// Import takes already-created external resources and brings them
+// under Terraform management. Import requires the exact type, name, and ID
+// of the resources to import.
+//
+// Importing into an address that already exists in the state is an error.
+//
+// This operation gracefully handles partial state. If during an import
+// there is a failure, all previously imported resources remain imported.
+func (c *Context) Import(opts *ImportOpts) (*State, error) {
+	v := c.acquireRun()
+	defer c.releaseRun(v)
+
+	// Copy our own state
+	c.state = c.state.DeepCopy()
+
+	// Build the graph with the import targets added in
+	builder := c.graphBuilder(&ContextGraphOpts{Validate: true}).(*BuiltinGraphBuilder)
+	builder.ImportTargets = opts.Targets
+	graph, err := builder.Build(RootModulePath)
+	if err != nil {
+		return c.state, err
+	}
+
+	// Walk it
+	if _, err := c.walk(graph, walkImport); err != nil {
+		return c.state, err
+	}
+
+	// Clean the state
+	c.state.prune()
+
+	return c.state, nil
+}
@@ -844,6 +844,86 @@ func TestContext2Plan_countVar(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_countModuleOutput(t *testing.T) {
+	m := testModule(t, "plan-count-module-output")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resources := plan.Diff.RootModule().Resources
+	for _, k := range []string{"aws_instance.foo.0", "aws_instance.foo.1", "aws_instance.foo.2"} {
+		if _, ok := resources[k]; !ok {
+			t.Fatalf("missing %s:\n%s", k, plan)
+		}
+	}
+	if len(resources) != 3 {
+		t.Fatalf("bad:\n%s", plan)
+	}
+}
+
+func TestContext2Plan_countModuleIndex(t *testing.T) {
+	m := testModule(t, "plan-count-module-index")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	plan, err := ctx.Plan()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resources := plan.Diff.RootModule().Resources
+	expected := map[string]string{
+		"aws_instance.foo.0": "a",
+		"aws_instance.foo.1": "b",
+		"aws_instance.foo.2": "c",
+	}
+	for k, v := range expected {
+		r, ok := resources[k]
+		if !ok {
+			t.Fatalf("missing %s:\n%s", k, plan)
+		}
+		if actual := r.Attributes["foo"].New; actual != v {
+			t.Fatalf("bad %s: %q\n\n%s", k, actual, plan)
+		}
+	}
+}
+
+func TestContext2Plan_countModuleComputed(t *testing.T) {
+	m := testModule(t, "plan-count-module-computed")
+	p := testProvider("aws")
+	p.DiffFn = testDiffFn
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"aws": testProviderFuncFixed(p),
+		},
+	})
+
+	_, err := ctx.Plan()
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "cannot be computed") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
 func TestContext2Plan_countZero(t *testing.T) {
 	m := testModule(t, "plan-count-zero")
 	p := testProvider("aws")
@@ -1329,6 +1409,38 @@ func TestContext2Plan_pathVar(t *testing.T) {
 	}
 }
 
+func TestContext2Plan_terraformEnv(t *testing.T) {
+	cases := map[string]string{
+		"":     DefaultEnvironment,
+		"prod": "prod",
+	}
+
+	for env, expected := range cases {
+		m := testModule(t, "plan-terraform-env")
+		p := testProvider("aws")
+		p.DiffFn = testDiffFn
+		ctx := testContext2(t, &ContextOpts{
+			Environment: env,
+			Module:      m,
+			Providers: map[string]ResourceProviderFactory{
+				"aws": testProviderFuncFixed(p),
+			},
+		})
+
+		plan, err := ctx.Plan()
+		if err != nil {
+			t.Fatalf("%q: err: %s", env, err)
+		}
+
+		actual := strings.TrimSpace(plan.String())
+		expected := strings.TrimSpace(
+			fmt.Sprintf(testTerraformPlanTerraformEnvStr, expected))
+		if actual != expected {
+			t.Fatalf("%q: bad:\n%s\n\nexpected:\n\n%s", env, actual, expected)
+		}
+	}
+}
+
 func TestContext2Plan_diffVar(t *testing.T) {
 	m := testModule(t, "plan-diffvar")
 	p := testProvider("aws")
@@ -408,6 +408,107 @@ func TestContext2Refresh_moduleVarModule(t *testing.T) {
 }
 
 // GH-70
+func TestContext2Refresh_dataResourceBasic(t *testing.T) {
+	p := testProvider("null")
+	m := testModule(t, "refresh-data-resource-basic")
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"null": testProviderFuncFixed(p),
+		},
+	})
+
+	p.ReadDataDiffReturn = &InstanceDiff{
+		Attributes: map[string]*ResourceAttrDiff{
+			"inputs.#": {
+				Old:  "0",
+				New:  "1",
+				Type: DiffAttrInput,
+			},
+			"inputs.test": {
+				Old:  "",
+				New:  "yes",
+				Type: DiffAttrInput,
+			},
+			"outputs.#": {
+				Old:         "",
+				NewComputed: true,
+				Type:        DiffAttrOutput,
+			},
+		},
+	}
+	p.ReadDataApplyReturn = &InstanceState{
+		ID: "-",
+		Attributes: map[string]string{
+			"inputs.#":     "1",
+			"inputs.test":  "yes",
+			"outputs.#":    "1",
+			"outputs.test": "yes",
+		},
+	}
+
+	s, err := ctx.Refresh()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !p.ReadDataDiffCalled {
+		t.Fatal("ReadDataDiff should have been called")
+	}
+	if !p.ReadDataApplyCalled {
+		t.Fatal("ReadDataApply should have been called")
+	}
+
+	mod := s.RootModule()
+	rs, ok := mod.Resources["data.null_data_source.testing"]
+	if !ok {
+		t.Fatalf("data source not in state: %#v", mod.Resources)
+	}
+	if rs.Type != "null_data_source" {
+		t.Fatalf("bad: %#v", rs)
+	}
+	if !reflect.DeepEqual(rs.Primary, p.ReadDataApplyReturn) {
+		t.Fatalf("bad: %#v", rs.Primary)
+	}
+}
+
+func TestContext2Refresh_dataOrphan(t *testing.T) {
+	p := testProvider("null")
+	m := testModule(t, "refresh-data-orphan")
+	ctx := testContext2(t, &ContextOpts{
+		Module: m,
+		Providers: map[string]ResourceProviderFactory{
+			"null": testProviderFuncFixed(p),
+		},
+		State: &State{
+			Modules: []*ModuleState{
+				&ModuleState{
+					Path: rootModulePath,
+					Resources: map[string]*ResourceState{
+						"data.null_data_source.testing": &ResourceState{
+							Type: "null_data_source",
+							Primary: &InstanceState{
+								ID: "-",
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	s, err := ctx.Refresh()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if p.RefreshCalled {
+		t.Fatal("refresh should not be called for a data source")
+	}
+
+	if _, ok := s.RootModule().Resources["data.null_data_source.testing"]; ok {
+		t.Fatalf("orphaned data source should be removed: %s", s)
+	}
+}
+
 func TestContext2Refresh_noState(t *testing.T) {
 	p := testProvider("aws")
 	m := testModule(t, "refresh-no-state")
@@ -283,13 +283,24 @@ type ResourceAttrDiff struct {
 	NewRemoved  bool        // True if this attribute is being removed
 	NewExtra    interface{} // Extra information for the provider
 	RequiresNew bool        // True if change requires new resource
+	Sensitive   bool        // True if the values must not be shown
 	Type        DiffAttrType
 }
 
 func (d *ResourceAttrDiff) GoString() string {
-	return fmt.Sprintf("*%#v", *d)
+	v := *d
+	if v.Sensitive {
+		v.Old = sensitiveValue
+		v.New = sensitiveValue
+		v.NewExtra = nil
+	}
+
+	return fmt.Sprintf("*%#v", v)
 }
 
+// sensitiveValue is shown in place of the values of sensitive attributes.
+const sensitiveValue = "<sensitive>"
+
 // DiffAttrType is an enum type that says whether a resource attribute
 // diff is an input attribute (comes from the configuration) or an
 // output attribute (comes as a result of applying the configuration). An
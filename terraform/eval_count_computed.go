@@ -0,0 +1,28 @@
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/config"
+)
+
+// EvalCountCheckComputed is an EvalNode that checks if a resource count
+// is computed and errors if so. The count can depend on module outputs,
+// which may in turn depend on values that aren't known until apply.
+type EvalCountCheckComputed struct {
+	Resource *config.Resource
+}
+
+func (n *EvalCountCheckComputed) Eval(ctx EvalContext) (interface{}, error) {
+	// A computed value is removed from the interpolated config and
+	// recorded as an unknown key, so we can't look at the value itself.
+	if len(n.Resource.RawCount.UnknownKeys()) > 0 {
+		return nil, fmt.Errorf(
+			"%s: value of 'count' cannot be computed. The count must be "+
+				"known at plan time, so it can't depend on values that are "+
+				"only known after other resources are created.",
+			n.Resource.Id())
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,71 @@
+package terraform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/config/lang/ast"
+)
+
+func TestEvalCountCheckComputed_impl(t *testing.T) {
+	var _ EvalNode = new(EvalCountCheckComputed)
+}
+
+func TestEvalCountCheckComputed(t *testing.T) {
+	rc := testEvalCountRawConfig(t, "3")
+	n := &EvalCountCheckComputed{
+		Resource: &config.Resource{
+			Name:     "foo",
+			Type:     "aws_instance",
+			RawCount: rc,
+		},
+	}
+
+	if _, err := n.Eval(&MockEvalContext{}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestEvalCountCheckComputed_computed(t *testing.T) {
+	rc := testEvalCountRawConfig(t, config.UnknownVariableValue)
+	n := &EvalCountCheckComputed{
+		Resource: &config.Resource{
+			Name:     "foo",
+			Type:     "aws_instance",
+			RawCount: rc,
+		},
+	}
+
+	_, err := n.Eval(&MockEvalContext{})
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "aws_instance.foo") {
+		t.Fatalf("bad: %s", err)
+	}
+}
+
+// testEvalCountRawConfig returns a count config that has been
+// interpolated with var.count set to the given value.
+func testEvalCountRawConfig(t *testing.T, v string) *config.RawConfig {
+	rc, err := config.NewRawConfig(map[string]interface{}{
+		"count": "${var.count}",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	rc.Key = "count"
+
+	vars := map[string]ast.Variable{
+		"var.count": ast.Variable{
+			Value: v,
+			Type:  ast.TypeString,
+		},
+	}
+	if err := rc.Interpolate(vars); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return rc
+}
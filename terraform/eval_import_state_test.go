@@ -0,0 +1,151 @@
+package terraform
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEvalImportState_impl(t *testing.T) {
+	var _ EvalNode = new(EvalImportState)
+}
+
+func TestEvalImportState(t *testing.T) {
+	hook := new(MockHook)
+	provider := &MockResourceProvider{
+		ImportStateReturn: []*InstanceState{
+			&InstanceState{ID: "foo", Ephemeral: EphemeralState{Type: "aws_instance"}},
+		},
+	}
+	info := &InstanceInfo{Id: "aws_instance.foo", Type: "aws_instance"}
+
+	var p ResourceProvider = provider
+	var output []*InstanceState
+	n := &EvalImportState{
+		Provider: &p,
+		Info:     info,
+		Id:       "bar",
+		Output:   &output,
+	}
+
+	ctx := &MockEvalContext{HookHook: hook}
+	if _, err := n.Eval(ctx); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !provider.ImportStateCalled {
+		t.Fatal("should call ImportState")
+	}
+	if provider.ImportStateID != "bar" {
+		t.Fatalf("bad: %#v", provider.ImportStateID)
+	}
+	if !reflect.DeepEqual(output, provider.ImportStateReturn) {
+		t.Fatalf("bad: %#v", output)
+	}
+
+	if !hook.PreImportStateCalled {
+		t.Fatal("should call PreImportState")
+	}
+	if hook.PreImportStateInfo != info || hook.PreImportStateId != "bar" {
+		t.Fatalf("bad: %#v %#v", hook.PreImportStateInfo, hook.PreImportStateId)
+	}
+	if !hook.PostImportStateCalled {
+		t.Fatal("should call PostImportState")
+	}
+	if !reflect.DeepEqual(hook.PostImportStateState, output) {
+		t.Fatalf("bad: %#v", hook.PostImportStateState)
+	}
+}
+
+func TestEvalImportState_error(t *testing.T) {
+	hook := new(MockHook)
+	provider := &MockResourceProvider{
+		ImportStateReturnError: errors.New("not found"),
+	}
+
+	var p ResourceProvider = provider
+	var output []*InstanceState
+	n := &EvalImportState{
+		Provider: &p,
+		Info:     &InstanceInfo{Id: "aws_instance.foo", Type: "aws_instance"},
+		Id:       "bar",
+		Output:   &output,
+	}
+
+	ctx := &MockEvalContext{HookHook: hook}
+	_, err := n.Eval(ctx)
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("bad: %s", err)
+	}
+
+	if output != nil {
+		t.Fatalf("bad: %#v", output)
+	}
+	if hook.PostImportStateCalled {
+		t.Fatal("should not call PostImportState")
+	}
+}
+
+func TestEvalImportState_preHookError(t *testing.T) {
+	hook := &MockHook{PreImportStateError: errors.New("hook")}
+	provider := new(MockResourceProvider)
+
+	var p ResourceProvider = provider
+	n := &EvalImportState{
+		Provider: &p,
+		Info:     &InstanceInfo{Id: "aws_instance.foo", Type: "aws_instance"},
+		Id:       "bar",
+	}
+
+	ctx := &MockEvalContext{HookHook: hook}
+	if _, err := n.Eval(ctx); err == nil {
+		t.Fatal("should error")
+	}
+	if provider.ImportStateCalled {
+		t.Fatal("should not call ImportState")
+	}
+}
+
+func TestEvalImportStateVerify_impl(t *testing.T) {
+	var _ EvalNode = new(EvalImportStateVerify)
+}
+
+func TestEvalImportStateVerify(t *testing.T) {
+	cases := []struct {
+		State *InstanceState
+		Error bool
+	}{
+		{
+			nil,
+			true,
+		},
+		{
+			&InstanceState{},
+			true,
+		},
+		{
+			&InstanceState{ID: "foo"},
+			false,
+		},
+	}
+
+	for i, tc := range cases {
+		n := &EvalImportStateVerify{
+			Info:  &InstanceInfo{Id: "aws_instance.foo", Type: "aws_instance"},
+			Id:    "bar",
+			State: &tc.State,
+		}
+
+		_, err := n.Eval(new(MockEvalContext))
+		if (err != nil) != tc.Error {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "(id: bar)") {
+			t.Fatalf("%d: bad: %s", i, err)
+		}
+	}
+}
@@ -30,6 +30,7 @@ func (n *EvalDeleteOutput) Eval(ctx EvalContext) (interface{}, error) {
 	}
 
 	delete(mod.Outputs, n.Name)
+	mod.setOutputSensitive(n.Name, false)
 
 	return nil, nil
 }
@@ -37,8 +38,9 @@ func (n *EvalDeleteOutput) Eval(ctx EvalContext) (interface{}, error) {
 // EvalWriteOutput is an EvalNode implementation that writes the output
 // for the given name to the current state.
 type EvalWriteOutput struct {
-	Name  string
-	Value *config.RawConfig
+	Name      string
+	Sensitive bool
+	Value     *config.RawConfig
 }
 
 // TODO: test
@@ -86,6 +88,7 @@ func (n *EvalWriteOutput) Eval(ctx EvalContext) (interface{}, error) {
 
 	// Write the output
 	mod.Outputs[n.Name] = valueRaw.(string)
+	mod.setOutputSensitive(n.Name, n.Sensitive)
 
 	return nil, nil
 }
@@ -0,0 +1,283 @@
+package terraform
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+)
+
+func TestEvalReadDataDiff_impl(t *testing.T) {
+	var _ EvalNode = new(EvalReadDataDiff)
+}
+
+func TestEvalReadDataDiff(t *testing.T) {
+	provider := &MockResourceProvider{
+		ReadDataDiffReturn: &InstanceDiff{
+			Attributes: map[string]*ResourceAttrDiff{
+				"foo": &ResourceAttrDiff{
+					New: "bar",
+				},
+			},
+		},
+	}
+
+	var p ResourceProvider = provider
+	info := &InstanceInfo{Id: "data.aws_ami.foo", Type: "aws_ami"}
+	rc := testResourceConfig(t, map[string]interface{}{"foo": "bar"})
+
+	var diff *InstanceDiff
+	var state *InstanceState
+	n := &EvalReadDataDiff{
+		Provider:    &p,
+		Output:      &diff,
+		OutputState: &state,
+		Config:      &rc,
+		Info:        info,
+	}
+	if _, err := n.Eval(new(MockEvalContext)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !provider.ReadDataDiffCalled {
+		t.Fatal("should call ReadDataDiff")
+	}
+	if provider.ReadDataDiffInfo != info || provider.ReadDataDiffDesired != rc {
+		t.Fatalf("bad: %#v %#v", provider.ReadDataDiffInfo, provider.ReadDataDiffDesired)
+	}
+
+	// The id is always computed
+	if a := diff.Attributes["id"]; a == nil || !a.NewComputed || !a.RequiresNew {
+		t.Fatalf("bad: %#v", diff)
+	}
+	if a := diff.Attributes["foo"]; a == nil || a.New != "bar" {
+		t.Fatalf("bad: %#v", diff)
+	}
+
+	if state == nil {
+		t.Fatal("state should be set")
+	}
+	if state.Attributes["foo"] != "bar" {
+		t.Fatalf("bad: %#v", state.Attributes)
+	}
+	if state.Attributes["id"] != config.UnknownVariableValue {
+		t.Fatalf("bad: %#v", state.Attributes)
+	}
+}
+
+func TestEvalReadDataDiff_computedConfig(t *testing.T) {
+	provider := &MockResourceProvider{
+		ReadDataDiffFn: func(info *InstanceInfo, c *ResourceConfig) (*InstanceDiff, error) {
+			// The provider can't read anything yet, so everything
+			// that depends on the computed key is computed as well.
+			if !c.IsComputed("foo") {
+				t.Fatalf("foo should be computed: %#v", c)
+			}
+
+			return &InstanceDiff{
+				Attributes: map[string]*ResourceAttrDiff{
+					"foo": &ResourceAttrDiff{
+						NewComputed: true,
+					},
+					"name": &ResourceAttrDiff{
+						NewComputed: true,
+					},
+				},
+			}, nil
+		},
+	}
+
+	var p ResourceProvider = provider
+	rc := &ResourceConfig{
+		ComputedKeys: []string{"foo"},
+		Raw:          map[string]interface{}{"foo": config.UnknownVariableValue},
+		Config:       map[string]interface{}{},
+	}
+
+	var diff *InstanceDiff
+	var state *InstanceState
+	n := &EvalReadDataDiff{
+		Provider:    &p,
+		Output:      &diff,
+		OutputState: &state,
+		Config:      &rc,
+		Info:        &InstanceInfo{Id: "data.aws_ami.foo", Type: "aws_ami"},
+	}
+	if _, err := n.Eval(new(MockEvalContext)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, k := range []string{"id", "foo", "name"} {
+		if a := diff.Attributes[k]; a == nil || !a.NewComputed {
+			t.Fatalf("%s should be computed: %#v", k, diff)
+		}
+
+		// Dependents see the attributes as unknown so they can plan
+		if v := state.Attributes[k]; v != config.UnknownVariableValue {
+			t.Fatalf("%s should be unknown: %#v", k, state.Attributes)
+		}
+	}
+}
+
+func TestEvalReadDataDiff_nilDiff(t *testing.T) {
+	var p ResourceProvider = new(MockResourceProvider)
+	rc := testResourceConfig(t, map[string]interface{}{})
+
+	var diff *InstanceDiff
+	n := &EvalReadDataDiff{
+		Provider: &p,
+		Output:   &diff,
+		Config:   &rc,
+		Info:     &InstanceInfo{Id: "data.aws_ami.foo", Type: "aws_ami"},
+	}
+	if _, err := n.Eval(new(MockEvalContext)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(diff.Attributes) != 1 || diff.Attributes["id"] == nil {
+		t.Fatalf("bad: %#v", diff)
+	}
+}
+
+func TestEvalReadDataDiff_error(t *testing.T) {
+	var p ResourceProvider = &MockResourceProvider{
+		ReadDataDiffReturnError: errors.New("error"),
+	}
+	rc := testResourceConfig(t, map[string]interface{}{})
+
+	var diff *InstanceDiff
+	n := &EvalReadDataDiff{
+		Provider: &p,
+		Output:   &diff,
+		Config:   &rc,
+		Info:     &InstanceInfo{Id: "data.aws_ami.foo", Type: "aws_ami"},
+	}
+	if _, err := n.Eval(new(MockEvalContext)); err == nil {
+		t.Fatal("should error")
+	}
+	if diff != nil {
+		t.Fatalf("bad: %#v", diff)
+	}
+}
+
+func TestEvalReadDataApply_impl(t *testing.T) {
+	var _ EvalNode = new(EvalReadDataApply)
+}
+
+// This reads the data source the way refresh does: a diff without an
+// output state followed right away by the read.
+func TestEvalReadDataApply_refresh(t *testing.T) {
+	hook := new(MockHook)
+	provider := &MockResourceProvider{
+		ReadDataDiffReturn: &InstanceDiff{
+			Attributes: map[string]*ResourceAttrDiff{
+				"foo": &ResourceAttrDiff{
+					New: "bar",
+				},
+			},
+		},
+		ReadDataApplyReturn: &InstanceState{
+			ID:         "ami-1234",
+			Attributes: map[string]string{"foo": "bar"},
+		},
+	}
+
+	var p ResourceProvider = provider
+	info := &InstanceInfo{Id: "data.aws_ami.foo", Type: "aws_ami"}
+	rc := testResourceConfig(t, map[string]interface{}{"foo": "bar"})
+	ctx := &MockEvalContext{HookHook: hook}
+
+	var diff *InstanceDiff
+	var state *InstanceState
+	nodes := []EvalNode{
+		&EvalReadDataDiff{
+			Provider: &p,
+			Output:   &diff,
+			Config:   &rc,
+			Info:     info,
+		},
+		&EvalReadDataApply{
+			Provider: &p,
+			Output:   &state,
+			Diff:     &diff,
+			Info:     info,
+		},
+	}
+	for _, n := range nodes {
+		if _, err := n.Eval(ctx); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	if !provider.ReadDataApplyCalled {
+		t.Fatal("should call ReadDataApply")
+	}
+	if provider.ReadDataApplyDiff != diff {
+		t.Fatalf("bad: %#v", provider.ReadDataApplyDiff)
+	}
+	if state != provider.ReadDataApplyReturn {
+		t.Fatalf("bad: %#v", state)
+	}
+
+	// Reading is presented to hooks as a refresh
+	if !hook.PreRefreshCalled || hook.PreRefreshInfo != info {
+		t.Fatal("should call PreRefresh")
+	}
+	if !hook.PostRefreshCalled || hook.PostRefreshState != state {
+		t.Fatal("should call PostRefresh")
+	}
+}
+
+func TestEvalReadDataApply_destroy(t *testing.T) {
+	hook := new(MockHook)
+	provider := new(MockResourceProvider)
+
+	var p ResourceProvider = provider
+	diff := &InstanceDiff{Destroy: true}
+	state := &InstanceState{ID: "ami-1234"}
+	n := &EvalReadDataApply{
+		Provider: &p,
+		Output:   &state,
+		Diff:     &diff,
+		Info:     &InstanceInfo{Id: "data.aws_ami.foo", Type: "aws_ami"},
+	}
+	if _, err := n.Eval(&MockEvalContext{HookHook: hook}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if state != nil {
+		t.Fatalf("bad: %#v", state)
+	}
+	if provider.ReadDataApplyCalled {
+		t.Fatal("should not call ReadDataApply")
+	}
+	if hook.PreRefreshCalled {
+		t.Fatal("should not call PreRefresh")
+	}
+}
+
+func TestEvalReadDataApply_error(t *testing.T) {
+	hook := new(MockHook)
+	var p ResourceProvider = &MockResourceProvider{
+		ReadDataApplyReturnError: errors.New("error"),
+	}
+
+	diff := new(InstanceDiff)
+	var state *InstanceState
+	n := &EvalReadDataApply{
+		Provider: &p,
+		Output:   &state,
+		Diff:     &diff,
+		Info:     &InstanceInfo{Id: "data.aws_ami.foo", Type: "aws_ami"},
+	}
+	if _, err := n.Eval(&MockEvalContext{HookHook: hook}); err == nil {
+		t.Fatal("should error")
+	}
+
+	if state != nil {
+		t.Fatalf("bad: %#v", state)
+	}
+	if hook.PostRefreshCalled {
+		t.Fatal("should not call PostRefresh")
+	}
+}
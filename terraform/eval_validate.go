@@ -107,6 +107,7 @@ type EvalValidateResource struct {
 	Config       **ResourceConfig
 	ResourceName string
 	ResourceType string
+	IsDataSource bool
 }
 
 func (n *EvalValidateResource) Eval(ctx EvalContext) (interface{}, error) {
@@ -114,7 +115,13 @@ func (n *EvalValidateResource) Eval(ctx EvalContext) (interface{}, error) {
 
 	provider := *n.Provider
 	cfg := *n.Config
-	warns, errs := provider.ValidateResource(n.ResourceType, cfg)
+	var warns []string
+	var errs []error
+	if n.IsDataSource {
+		warns, errs = provider.ValidateDataSource(n.ResourceType, cfg)
+	} else {
+		warns, errs = provider.ValidateResource(n.ResourceType, cfg)
+	}
 
 	// If the resouce name doesn't match the name regular
 	// expression, show a warning.
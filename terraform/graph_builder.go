@@ -77,6 +77,10 @@ type BuiltinGraphBuilder struct {
 	// `terraform plan -destroy`
 	Destroy bool
 
+	// ImportTargets is the list of resources to import. This is only set
+	// for `terraform import`.
+	ImportTargets []*ImportTarget
+
 	// Determines whether the GraphBuilder should perform graph validation before
 	// returning the Graph. Generally you want this to be done, except when you'd
 	// like to inspect a problematic graph.
@@ -110,6 +114,9 @@ func (b *BuiltinGraphBuilder) Steps(path []string) []GraphTransformer {
 			Targeting: len(b.Targets) > 0,
 		},
 
+		// Add the resources that we're importing, if any
+		&ImportStateTransformer{Targets: b.ImportTargets},
+
 		// Output-related transformations
 		&AddOutputOrphanTransformer{State: b.State},
 
@@ -156,6 +156,16 @@ func (n *GraphNodeConfigResource) DynamicExpand(ctx EvalContext) (*Graph, error)
 		})
 	}
 
+	// Data sources never get destroy nodes, so the orphans left behind
+	// by a decrease in count are found here instead.
+	if n.DestroyMode == DestroyNone && n.Resource.Mode == config.DataResourceMode {
+		steps = append(steps, &OrphanTransformer{
+			State:     state,
+			View:      n.Resource.Id(),
+			Targeting: len(n.Targets) > 0,
+		})
+	}
+
 	// Additional destroy modifications.
 	switch n.DestroyMode {
 	case DestroyPrimary:
@@ -214,6 +224,11 @@ func (n *GraphNodeConfigResource) EvalTree() EvalNode {
 				Ops:  []walkOperation{walkValidate},
 				Node: &EvalValidateCount{Resource: n.Resource},
 			},
+			&EvalOpFilter{
+				Ops: []walkOperation{
+					walkRefresh, walkPlan, walkPlanDestroy, walkApply, walkDestroy},
+				Node: &EvalCountCheckComputed{Resource: n.Resource},
+			},
 			&EvalCountFixZeroOneBoundary{Resource: n.Resource},
 		},
 	}
@@ -241,6 +256,12 @@ func (n *GraphNodeConfigResource) DestroyNode(mode GraphNodeDestroyMode) GraphNo
 		return nil
 	}
 
+	// Data sources are only ever read, so they are never destroyed.
+	// They're removed from the state by their own node instead.
+	if n.Resource.Mode == config.DataResourceMode {
+		return nil
+	}
+
 	result := &graphNodeResourceDestroy{
 		GraphNodeConfigResource: *n,
 		Original:                n,
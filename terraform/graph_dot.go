@@ -99,10 +99,11 @@ func graphDotSubgraph(
 		return err
 	}
 
+	clusters := make(map[string]*dot.Subgraph)
 	for _, v := range toDraw {
 		dn := v.(GraphNodeDotter)
 		nodeName := graphDotNodeName(modName, v)
-		sg.AddNode(dn.DotNode(nodeName, opts))
+		graphDotModuleCluster(sg, clusters, v).AddNode(dn.DotNode(nodeName, opts))
 
 		// Draw all the edges from this vertex to other nodes
 		targets := dag.AsVertexList(g.DownEdges(v))
@@ -162,6 +163,35 @@ func graphDotSubgraph(
 	return nil
 }
 
+// graphDotModuleCluster returns the subgraph to draw the node for v in.
+// Nodes that were flattened into the graph from a module are grouped into
+// a cluster for that module, nested within the clusters of its parents.
+// Edges are still drawn in sg, so only the nodes are moved.
+func graphDotModuleCluster(
+	sg *dot.Subgraph, clusters map[string]*dot.Subgraph, v dag.Vertex) *dot.Subgraph {
+	pn, ok := v.(GraphNodeSubPath)
+	if !ok {
+		return sg
+	}
+
+	result := sg
+	path := pn.Path()
+	for i := 2; i <= len(path); i++ {
+		name := modulePrefixStr(path[:i])
+		cluster, ok := clusters[name]
+		if !ok {
+			cluster = result.AddSubgraph(name)
+			cluster.Cluster = true
+			cluster.AddAttr("label", name)
+			clusters[name] = cluster
+		}
+
+		result = cluster
+	}
+
+	return result
+}
+
 func graphDotNodeName(modName, v dag.Vertex) string {
 	return fmt.Sprintf("[%s] %s", modName, dag.VertexName(v))
 }
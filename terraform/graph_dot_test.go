@@ -105,6 +105,46 @@ digraph {
 		"[root] C" -> "[root] A" [color = "red", penwidth = "2.0"]
 		"[root] C" -> "[root] B"
 	}
+}
+			`,
+		},
+		"flattened modules": {
+			Graph: func() *Graph {
+				var g Graph
+				root := &testDrawableOrigin{"root"}
+				g.Add(root)
+
+				g.Add(&testDrawablePath{
+					VertexName:      "module.foo.A",
+					PathValue:       []string{"root", "foo"},
+					DependentOnMock: []string{"root"},
+				})
+				g.Add(&testDrawablePath{
+					VertexName:      "module.foo.module.bar.B",
+					PathValue:       []string{"root", "foo", "bar"},
+					DependentOnMock: []string{"module.foo.A"},
+				})
+
+				g.ConnectDependents()
+				return &g
+			},
+			Expect: `
+digraph {
+	compound = "true"
+	newrank = "true"
+	subgraph "root" {
+		"[root] root"
+		"[root] module.foo.A" -> "[root] root"
+		"[root] module.foo.module.bar.B" -> "[root] module.foo.A"
+		subgraph "cluster_module.foo" {
+			label = "module.foo"
+			"[root] module.foo.A"
+			subgraph "cluster_module.foo.module.bar" {
+				label = "module.foo.module.bar"
+				"[root] module.foo.module.bar.B"
+			}
+		}
+	}
 }
 			`,
 		},
@@ -250,6 +290,28 @@ func (node *testDrawable) DependentOn() []string {
 	return node.DependentOnMock
 }
 
+type testDrawablePath struct {
+	VertexName      string
+	PathValue       []string
+	DependentOnMock []string
+}
+
+func (node *testDrawablePath) Name() string {
+	return node.VertexName
+}
+func (node *testDrawablePath) Path() []string {
+	return node.PathValue
+}
+func (node *testDrawablePath) DotNode(n string, opts *GraphDotOpts) *dot.Node {
+	return dot.NewNode(n, map[string]string{})
+}
+func (node *testDrawablePath) DependableName() []string {
+	return []string{node.VertexName}
+}
+func (node *testDrawablePath) DependentOn() []string {
+	return node.DependentOnMock
+}
+
 type testDrawableOrigin struct {
 	VertexName string
 }
@@ -81,11 +81,12 @@ func (w *ContextGraphWalker) EnterPath(path []string) EvalContext {
 		StateValue:          w.Context.state,
 		StateLock:           &w.Context.stateLock,
 		Interpolater: &Interpolater{
-			Operation: w.Operation,
-			Module:    w.Context.module,
-			State:     w.Context.state,
-			StateLock: &w.Context.stateLock,
-			Variables: variables,
+			Operation:   w.Operation,
+			Module:      w.Context.module,
+			State:       w.Context.state,
+			StateLock:   &w.Context.stateLock,
+			Variables:   variables,
+			Environment: w.Context.environment,
 		},
 		InterpolaterVars:    w.interpolaterVars,
 		InterpolaterVarLock: &w.interpolaterVarLock,
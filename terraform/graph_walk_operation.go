@@ -14,4 +14,5 @@ const (
 	walkRefresh
 	walkValidate
 	walkDestroy
+	walkImport
 )
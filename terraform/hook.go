@@ -50,6 +50,11 @@ type Hook interface {
 	PreRefresh(*InstanceInfo, *InstanceState) (HookAction, error)
 	PostRefresh(*InstanceInfo, *InstanceState) (HookAction, error)
 
+	// PreImportState and PostImportState are called before and after
+	// a single resource's state is being imported.
+	PreImportState(*InstanceInfo, string) (HookAction, error)
+	PostImportState(*InstanceInfo, []*InstanceState) (HookAction, error)
+
 	// PostStateUpdate is called after the state is updated.
 	PostStateUpdate(*State) (HookAction, error)
 }
@@ -103,6 +108,14 @@ func (*NilHook) PostRefresh(*InstanceInfo, *InstanceState) (HookAction, error) {
 	return HookActionContinue, nil
 }
 
+func (*NilHook) PreImportState(*InstanceInfo, string) (HookAction, error) {
+	return HookActionContinue, nil
+}
+
+func (*NilHook) PostImportState(*InstanceInfo, []*InstanceState) (HookAction, error) {
+	return HookActionContinue, nil
+}
+
 func (*NilHook) PostStateUpdate(*State) (HookAction, error) {
 	return HookActionContinue, nil
 }
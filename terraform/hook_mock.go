@@ -70,6 +70,18 @@ type MockHook struct {
 	PreRefreshReturn HookAction
 	PreRefreshError  error
 
+	PreImportStateCalled bool
+	PreImportStateInfo   *InstanceInfo
+	PreImportStateId     string
+	PreImportStateReturn HookAction
+	PreImportStateError  error
+
+	PostImportStateCalled bool
+	PostImportStateInfo   *InstanceInfo
+	PostImportStateState  []*InstanceState
+	PostImportStateReturn HookAction
+	PostImportStateError  error
+
 	PostStateUpdateCalled bool
 	PostStateUpdateState  *State
 	PostStateUpdateReturn HookAction
@@ -158,6 +170,20 @@ func (h *MockHook) PostRefresh(n *InstanceInfo, s *InstanceState) (HookAction, e
 	return h.PostRefreshReturn, h.PostRefreshError
 }
 
+func (h *MockHook) PreImportState(info *InstanceInfo, id string) (HookAction, error) {
+	h.PreImportStateCalled = true
+	h.PreImportStateInfo = info
+	h.PreImportStateId = id
+	return h.PreImportStateReturn, h.PreImportStateError
+}
+
+func (h *MockHook) PostImportState(info *InstanceInfo, s []*InstanceState) (HookAction, error) {
+	h.PostImportStateCalled = true
+	h.PostImportStateInfo = info
+	h.PostImportStateState = s
+	return h.PostImportStateReturn, h.PostImportStateError
+}
+
 func (h *MockHook) PostStateUpdate(s *State) (HookAction, error) {
 	h.PostStateUpdateCalled = true
 	h.PostStateUpdateState = s
@@ -18,6 +18,10 @@ const (
 	// VarEnvPrefix is the prefix of variables that are read from
 	// the environment to set variables here.
 	VarEnvPrefix = "TF_VAR_"
+
+	// DefaultEnvironment is the name of the environment that is used
+	// when none is selected.
+	DefaultEnvironment = "default"
 )
 
 // Interpolater is the structure responsible for determining the values
@@ -28,6 +32,10 @@ type Interpolater struct {
 	State     *State
 	StateLock *sync.RWMutex
 	Variables map[string]string
+
+	// Environment is the name of the current environment, available
+	// as "terraform.env".
+	Environment string
 }
 
 // InterpolationScope is the current scope of execution. This is required
@@ -73,6 +81,8 @@ func (i *Interpolater) Values(
 			err = i.valueResourceVar(scope, n, v, result)
 		case *config.SelfVariable:
 			err = i.valueSelfVar(scope, n, v, result)
+		case *config.TerraformVariable:
+			err = i.valueTerraformVar(scope, n, v, result)
 		case *config.UserVariable:
 			err = i.valueUserVar(scope, n, v, result)
 		default:
@@ -249,6 +259,29 @@ func (i *Interpolater) valueSelfVar(
 	return i.valueResourceVar(scope, n, rv, result)
 }
 
+func (i *Interpolater) valueTerraformVar(
+	scope *InterpolationScope,
+	n string,
+	v *config.TerraformVariable,
+	result map[string]ast.Variable) error {
+	switch v.Type {
+	case config.TerraformValueEnv:
+		env := i.Environment
+		if env == "" {
+			env = DefaultEnvironment
+		}
+
+		result[n] = ast.Variable{
+			Value: env,
+			Type:  ast.TypeString,
+		}
+	default:
+		return fmt.Errorf("%s: unknown terraform type: %#v", n, v.Type)
+	}
+
+	return nil
+}
+
 func (i *Interpolater) valueUserVar(
 	scope *InterpolationScope,
 	n string,
@@ -384,7 +417,10 @@ MISSING:
 	//
 	// For an input walk, computed values are okay to return because we're only
 	// looking for missing variables to prompt the user for.
-	if i.Operation == walkRefresh || i.Operation == walkPlanDestroy || i.Operation == walkDestroy || i.Operation == walkInput {
+	//
+	// An import walk only needs the provider configuration, so computed
+	// values elsewhere are fine as well.
+	if i.Operation == walkRefresh || i.Operation == walkPlanDestroy || i.Operation == walkDestroy || i.Operation == walkInput || i.Operation == walkImport {
 		return config.UnknownVariableValue, nil
 	}
 
@@ -481,7 +517,7 @@ func (i *Interpolater) computeResourceMultiVariable(
 		//
 		// For an input walk, computed values are okay to return because we're only
 		// looking for missing variables to prompt the user for.
-		if i.Operation == walkRefresh || i.Operation == walkPlanDestroy || i.Operation == walkDestroy || i.Operation == walkInput {
+		if i.Operation == walkRefresh || i.Operation == walkPlanDestroy || i.Operation == walkDestroy || i.Operation == walkInput || i.Operation == walkImport {
 			return config.UnknownVariableValue, nil
 		}
 
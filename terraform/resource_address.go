@@ -47,6 +47,48 @@ func ParseResourceAddress(s string) (*ResourceAddress, error) {
 	}, nil
 }
 
+// String returns the address in the same format that
+// ParseResourceAddress accepts.
+func (addr *ResourceAddress) String() string {
+	var result []string
+	for _, p := range addr.Path {
+		result = append(result, "module", p)
+	}
+
+	if addr.Type != "" {
+		result = append(result, addr.Type)
+	}
+
+	if addr.Name != "" {
+		result = append(result, addr.Name)
+	}
+
+	switch addr.InstanceType {
+	case TypeTainted:
+		result = append(result, "tainted")
+	case TypeDeposed:
+		result = append(result, "deposed")
+	}
+
+	s := strings.Join(result, ".")
+	if addr.Index != -1 {
+		s += fmt.Sprintf("[%d]", addr.Index)
+	}
+
+	return s
+}
+
+// stateKey returns the key used for this address within the resources
+// of a module state.
+func (addr *ResourceAddress) stateKey() string {
+	key := fmt.Sprintf("%s.%s", addr.Type, addr.Name)
+	if addr.Index != -1 {
+		key += fmt.Sprintf(".%d", addr.Index)
+	}
+
+	return key
+}
+
 func (addr *ResourceAddress) Equals(raw interface{}) bool {
 	other, ok := raw.(*ResourceAddress)
 	if !ok {
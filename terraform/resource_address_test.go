@@ -118,6 +118,28 @@ func TestParseResourceAddress(t *testing.T) {
 	}
 }
 
+func TestResourceAddressString(t *testing.T) {
+	cases := []string{
+		"aws_instance.foo",
+		"aws_instance.foo[2]",
+		"aws_instance.foo.tainted[1]",
+		"aws_instance.foo.deposed",
+		"module.a.aws_instance.foo",
+		"module.a.module.b",
+	}
+
+	for _, tc := range cases {
+		addr, err := ParseResourceAddress(tc)
+		if err != nil {
+			t.Fatalf("unexpected err: %#v", err)
+		}
+
+		if actual := addr.String(); actual != tc {
+			t.Fatalf("bad: %q\n\ngot: %q", tc, actual)
+		}
+	}
+}
+
 func TestResourceAddressEquals(t *testing.T) {
 	cases := map[string]struct {
 		Address *ResourceAddress
@@ -393,6 +393,11 @@ type ModuleState struct {
 	// This allows operators to inspect values at the boundaries.
 	Outputs map[string]string `json:"outputs"`
 
+	// SensitiveOutputs are the names of the outputs whose values are
+	// sensitive and shouldn't be shown in the CLI output. This is kept
+	// sorted.
+	SensitiveOutputs []string `json:"sensitive_outputs,omitempty"`
+
 	// Resources is a mapping of the logically named resource to
 	// the state of the resource. Each resource may actually have
 	// N instances underneath, although a user only needs to think
@@ -514,6 +519,38 @@ func (m *ModuleState) View(id string) *ModuleState {
 	return r
 }
 
+// OutputSensitive returns whether the output with the given name is
+// sensitive.
+func (m *ModuleState) OutputSensitive(name string) bool {
+	for _, n := range m.SensitiveOutputs {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setOutputSensitive marks the output with the given name as sensitive
+// or not.
+func (m *ModuleState) setOutputSensitive(name string, sensitive bool) {
+	result := make([]string, 0, len(m.SensitiveOutputs)+1)
+	for _, n := range m.SensitiveOutputs {
+		if n != name {
+			result = append(result, n)
+		}
+	}
+	if sensitive {
+		result = append(result, name)
+		sort.Strings(result)
+	}
+
+	m.SensitiveOutputs = nil
+	if len(result) > 0 {
+		m.SensitiveOutputs = result
+	}
+}
+
 func (m *ModuleState) init() {
 	if m.Outputs == nil {
 		m.Outputs = make(map[string]string)
@@ -536,6 +573,10 @@ func (m *ModuleState) deepcopy() *ModuleState {
 	for k, v := range m.Outputs {
 		n.Outputs[k] = v
 	}
+	if m.SensitiveOutputs != nil {
+		n.SensitiveOutputs = make([]string, len(m.SensitiveOutputs))
+		copy(n.SensitiveOutputs, m.SensitiveOutputs)
+	}
 	for k, v := range m.Resources {
 		n.Resources[k] = v.deepcopy()
 	}
@@ -557,6 +598,12 @@ func (m *ModuleState) prune() {
 			delete(m.Outputs, k)
 		}
 	}
+
+	for _, k := range m.SensitiveOutputs {
+		if _, ok := m.Outputs[k]; !ok {
+			m.setOutputSensitive(k, false)
+		}
+	}
 }
 
 func (m *ModuleState) sort() {
@@ -797,6 +844,41 @@ func (r *ResourceState) Taint() {
 	r.Primary = nil
 }
 
+// Untaint takes a tainted instance and makes it the primary instance
+// again. If there is more than one tainted instance, index selects which
+// one to restore. An index of -1 selects the only tainted instance, and
+// is an error if there is more than one.
+func (r *ResourceState) Untaint(index int) error {
+	if len(r.Tainted) == 0 {
+		return fmt.Errorf("Nothing to untaint.")
+	}
+	if r.Primary != nil {
+		return fmt.Errorf(
+			"Resource has a primary instance in the state that would be\n" +
+				"overwritten by untainting. If you want to restore a tainted\n" +
+				"instance, taint the existing primary instance first.")
+	}
+	if index == -1 && len(r.Tainted) > 1 {
+		return fmt.Errorf(
+			"There are %d tainted instances for this resource, please\n"+
+				"specify an index to select which one to untaint.",
+			len(r.Tainted))
+	}
+	if index == -1 {
+		index = 0
+	}
+	if index < 0 || index >= len(r.Tainted) {
+		return fmt.Errorf(
+			"There are %d tainted instances for this resource, the index\n"+
+				"specified (%d) is out of range.",
+			len(r.Tainted), index)
+	}
+
+	r.Primary = r.Tainted[index]
+	r.Tainted = append(r.Tainted[:index], r.Tainted[index+1:]...)
+	return nil
+}
+
 func (r *ResourceState) init() {
 	if r.Primary == nil {
 		r.Primary = &InstanceState{}
@@ -900,6 +982,11 @@ type InstanceState struct {
 	// ignored by Terraform core. It's meant to be used for accounting by
 	// external client code.
 	Meta map[string]string `json:"meta,omitempty"`
+
+	// SensitiveAttributes are the keys of the attributes whose values
+	// are sensitive and shouldn't be shown in the CLI output. This is
+	// kept sorted.
+	SensitiveAttributes []string `json:"sensitive_attributes,omitempty"`
 }
 
 func (i *InstanceState) init() {
@@ -932,9 +1019,24 @@ func (i *InstanceState) deepcopy() *InstanceState {
 			n.Meta[k] = v
 		}
 	}
+	if i.SensitiveAttributes != nil {
+		n.SensitiveAttributes = make([]string, len(i.SensitiveAttributes))
+		copy(n.SensitiveAttributes, i.SensitiveAttributes)
+	}
 	return n
 }
 
+// AttributeSensitive returns whether the attribute with the given key is
+// sensitive.
+func (i *InstanceState) AttributeSensitive(k string) bool {
+	if i == nil {
+		return false
+	}
+
+	idx := sort.SearchStrings(i.SensitiveAttributes, k)
+	return idx < len(i.SensitiveAttributes) && i.SensitiveAttributes[idx] == k
+}
+
 func (s *InstanceState) Empty() bool {
 	return s == nil || s.ID == ""
 }
@@ -1004,9 +1106,18 @@ func (s *InstanceState) MergeDiff(d *InstanceDiff) *InstanceState {
 		}
 	}
 	if d != nil {
+		sensitive := make(map[string]struct{})
+		for _, k := range result.SensitiveAttributes {
+			sensitive[k] = struct{}{}
+		}
+
 		for k, diff := range d.Attributes {
+			if diff.Sensitive {
+				sensitive[k] = struct{}{}
+			}
 			if diff.NewRemoved {
 				delete(result.Attributes, k)
+				delete(sensitive, k)
 				continue
 			}
 			if diff.NewComputed {
@@ -1016,13 +1127,30 @@ func (s *InstanceState) MergeDiff(d *InstanceDiff) *InstanceState {
 
 			result.Attributes[k] = diff.New
 		}
+
+		result.SensitiveAttributes = nil
+		for k, _ := range sensitive {
+			result.SensitiveAttributes = append(result.SensitiveAttributes, k)
+		}
+		sort.Strings(result.SensitiveAttributes)
 	}
 
 	return result
 }
 
 func (i *InstanceState) GoString() string {
-	return fmt.Sprintf("*%#v", *i)
+	v := *i
+	if len(v.SensitiveAttributes) > 0 {
+		v.Attributes = make(map[string]string, len(i.Attributes))
+		for k, attr := range i.Attributes {
+			if i.AttributeSensitive(k) {
+				attr = sensitiveValue
+			}
+			v.Attributes[k] = attr
+		}
+	}
+
+	return fmt.Sprintf("*%#v", v)
 }
 
 func (i *InstanceState) String() string {
@@ -1059,6 +1187,12 @@ type EphemeralState struct {
 	// used to connect to the resource for provisioning. For example,
 	// this could contain SSH or WinRM credentials.
 	ConnInfo map[string]string `json:"-"`
+
+	// Type is used to specify the resource type for this instance. This
+	// is only required for import operations (as documented). If the
+	// documentation doesn't state that you need to set this, then don't
+	// set it.
+	Type string `json:"-"`
 }
 
 func (e *EphemeralState) init() {
@@ -1071,7 +1205,7 @@ func (e *EphemeralState) deepcopy() *EphemeralState {
 	if e == nil {
 		return nil
 	}
-	n := &EphemeralState{}
+	n := &EphemeralState{Type: e.Type}
 	if e.ConnInfo != nil {
 		n.ConnInfo = make(map[string]string, len(e.ConnInfo))
 		for k, v := range e.ConnInfo {
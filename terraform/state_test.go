@@ -477,6 +477,103 @@ func TestResourceStateTaint(t *testing.T) {
 	}
 }
 
+func TestResourceStateUntaint(t *testing.T) {
+	cases := map[string]struct {
+		Input          *ResourceState
+		Index          int
+		ExpectedOutput *ResourceState
+		ExpectedErrMsg string
+	}{
+		"no tainted": {
+			Input:          &ResourceState{},
+			Index:          -1,
+			ExpectedErrMsg: "Nothing to untaint",
+		},
+
+		"one tainted, no primary": {
+			Input: &ResourceState{
+				Tainted: []*InstanceState{
+					&InstanceState{ID: "foo"},
+				},
+			},
+			Index: -1,
+			ExpectedOutput: &ResourceState{
+				Primary: &InstanceState{ID: "foo"},
+				Tainted: []*InstanceState{},
+			},
+		},
+
+		"one tainted, existing primary": {
+			Input: &ResourceState{
+				Primary: &InstanceState{ID: "foo"},
+				Tainted: []*InstanceState{
+					&InstanceState{ID: "bar"},
+				},
+			},
+			Index:          -1,
+			ExpectedErrMsg: "primary instance",
+		},
+
+		"multiple tainted, no index": {
+			Input: &ResourceState{
+				Tainted: []*InstanceState{
+					&InstanceState{ID: "foo"},
+					&InstanceState{ID: "bar"},
+				},
+			},
+			Index:          -1,
+			ExpectedErrMsg: "please\nspecify an index",
+		},
+
+		"multiple tainted, with index": {
+			Input: &ResourceState{
+				Tainted: []*InstanceState{
+					&InstanceState{ID: "foo"},
+					&InstanceState{ID: "bar"},
+					&InstanceState{ID: "baz"},
+				},
+			},
+			Index: 1,
+			ExpectedOutput: &ResourceState{
+				Primary: &InstanceState{ID: "bar"},
+				Tainted: []*InstanceState{
+					&InstanceState{ID: "foo"},
+					&InstanceState{ID: "baz"},
+				},
+			},
+		},
+
+		"index out of range": {
+			Input: &ResourceState{
+				Tainted: []*InstanceState{
+					&InstanceState{ID: "foo"},
+				},
+			},
+			Index:          1,
+			ExpectedErrMsg: "out of range",
+		},
+	}
+
+	for k, tc := range cases {
+		err := tc.Input.Untaint(tc.Index)
+		if tc.ExpectedErrMsg == "" && err != nil {
+			t.Fatalf("%s: unexpected err: %s", k, err)
+		}
+		if tc.ExpectedErrMsg != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedErrMsg) {
+				t.Fatalf("%s: expected err containing %q, got: %v",
+					k, tc.ExpectedErrMsg, err)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(tc.Input, tc.ExpectedOutput) {
+			t.Fatalf(
+				"Failure: %s\n\nExpected: %#v\n\nGot: %#v",
+				k, tc.ExpectedOutput, tc.Input)
+		}
+	}
+}
+
 func TestInstanceStateEmpty(t *testing.T) {
 	cases := map[string]struct {
 		In     *InstanceState
@@ -665,6 +762,44 @@ func TestInstanceState_MergeDiff(t *testing.T) {
 	}
 }
 
+func TestInstanceState_MergeDiff_sensitive(t *testing.T) {
+	is := InstanceState{
+		ID: "foo",
+		Attributes: map[string]string{
+			"password": "foo",
+			"token":    "bar",
+		},
+		SensitiveAttributes: []string{"token"},
+	}
+
+	diff := &InstanceDiff{
+		Attributes: map[string]*ResourceAttrDiff{
+			"password": &ResourceAttrDiff{
+				Old:       "foo",
+				New:       "bar",
+				Sensitive: true,
+			},
+			"token": &ResourceAttrDiff{
+				NewRemoved: true,
+				Sensitive:  true,
+			},
+		},
+	}
+
+	is2 := is.MergeDiff(diff)
+
+	expected := []string{"password"}
+	if !reflect.DeepEqual(expected, is2.SensitiveAttributes) {
+		t.Fatalf("bad: %#v", is2.SensitiveAttributes)
+	}
+	if !is2.AttributeSensitive("password") || is2.AttributeSensitive("token") {
+		t.Fatalf("bad: %#v", is2)
+	}
+	if strings.Contains(is2.GoString(), `"bar"`) {
+		t.Fatalf("sensitive value in GoString: %s", is2.GoString())
+	}
+}
+
 func TestInstanceState_MergeDiff_nil(t *testing.T) {
 	var is *InstanceState = nil
 
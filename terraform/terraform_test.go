@@ -323,6 +323,11 @@ aws_instance.foo.1:
   type = aws_instance
 `
 
+const testTerraformApplyDataBasicStr = `
+data.null_data_source.testing:
+  ID = yo
+`
+
 const testTerraformApplyMinimalStr = `
 aws_instance.bar:
   ID = foo
@@ -1288,6 +1293,18 @@ STATE:
 <no state>
 `
 
+const testTerraformPlanTerraformEnvStr = `
+DIFF:
+
+CREATE: aws_instance.foo
+  env:  "" => "%s"
+  type: "" => "aws_instance"
+
+STATE:
+
+<no state>
+`
+
 const testTerraformPlanIgnoreChangesStr = `
 DIFF:
 
@@ -0,0 +1,12 @@
+resource "aws_instance" "foo" {
+    num = "2"
+}
+
+output "foo_num" {
+    value = "${aws_instance.foo.num}"
+}
+
+output "secret" {
+    value = "${aws_instance.foo.num}"
+    sensitive = true
+}
@@ -0,0 +1,3 @@
+output "ids" {
+    value = "${split(",", "a,b,c")}"
+}
@@ -0,0 +1,8 @@
+module "child" {
+    source = "./child"
+}
+
+resource "aws_instance" "foo" {
+    count = 3
+    foo = "${module.child.ids[count.index]}"
+}
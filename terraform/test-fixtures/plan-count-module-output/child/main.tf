@@ -0,0 +1,5 @@
+variable "num" {}
+
+output "num" {
+    value = "${var.num}"
+}
@@ -0,0 +1,9 @@
+module "child" {
+    source = "./child"
+    num = "3"
+}
+
+resource "aws_instance" "foo" {
+    count = "${module.child.num}"
+    foo = "foo"
+}
@@ -0,0 +1 @@
+# Nothing here, the data source in the state is an orphan.
@@ -3,6 +3,7 @@ package terraform
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/config/module"
@@ -193,11 +194,21 @@ func (n *graphNodeOrphanResource) Name() string {
 }
 
 func (n *graphNodeOrphanResource) ProvidedBy() []string {
-	return []string{resourceProvider(n.ResourceName, n.Provider)}
+	return []string{resourceProvider(
+		strings.TrimPrefix(n.ResourceName, "data."), n.Provider)}
+}
+
+// isData returns true if this orphan is a data source.
+func (n *graphNodeOrphanResource) isData() bool {
+	return strings.HasPrefix(n.ResourceName, "data.")
 }
 
 // GraphNodeEvalable impl.
 func (n *graphNodeOrphanResource) EvalTree() EvalNode {
+	if n.isData() {
+		return n.dataResourceEvalTree()
+	}
+
 	var provider ResourceProvider
 	var state *InstanceState
 
@@ -306,13 +317,34 @@ func (n *graphNodeOrphanResource) EvalTree() EvalNode {
 	return seq
 }
 
+// dataResourceEvalTree is the EvalTree for an orphaned data source.
+// There is nothing to destroy, so it is just removed from the state.
+func (n *graphNodeOrphanResource) dataResourceEvalTree() EvalNode {
+	var state *InstanceState
+	return &EvalOpFilter{
+		Ops: []walkOperation{walkRefresh, walkApply, walkDestroy},
+		Node: &EvalSequence{
+			Nodes: []EvalNode{
+				&EvalWriteState{
+					Name:         n.ResourceName,
+					ResourceType: n.ResourceType,
+					Provider:     n.Provider,
+					Dependencies: n.DependentOn(),
+					State:        &state,
+				},
+				&EvalUpdateStateHook{},
+			},
+		},
+	}
+}
+
 func (n *graphNodeOrphanResource) dependableName() string {
 	return n.ResourceName
 }
 
 // GraphNodeDestroyable impl.
 func (n *graphNodeOrphanResource) DestroyNode(mode GraphNodeDestroyMode) GraphNodeDestroy {
-	if mode != DestroyPrimary {
+	if mode != DestroyPrimary || n.isData() {
 		return nil
 	}
 
@@ -346,7 +378,7 @@ func (n *graphNodeOrphanResourceFlat) Path() []string {
 
 // GraphNodeDestroyable impl.
 func (n *graphNodeOrphanResourceFlat) DestroyNode(mode GraphNodeDestroyMode) GraphNodeDestroy {
-	if mode != DestroyPrimary {
+	if mode != DestroyPrimary || n.isData() {
 		return nil
 	}
 
@@ -195,6 +195,17 @@ func (n *graphNodeExpandedResource) StateDependencies() []string {
 
 // GraphNodeEvalable impl.
 func (n *graphNodeExpandedResource) EvalTree() EvalNode {
+	switch n.Resource.Mode {
+	case config.ManagedResourceMode:
+		return n.managedResourceEvalTree()
+	case config.DataResourceMode:
+		return n.dataResourceEvalTree()
+	default:
+		panic(fmt.Errorf("unsupported resource mode %s", n.Resource.Mode))
+	}
+}
+
+func (n *graphNodeExpandedResource) managedResourceEvalTree() EvalNode {
 	var diff *InstanceDiff
 	var provider ResourceProvider
 	var resourceConfig *ResourceConfig
@@ -552,6 +563,258 @@ func (n *graphNodeExpandedResource) EvalTree() EvalNode {
 	return seq
 }
 
+func (n *graphNodeExpandedResource) dataResourceEvalTree() EvalNode {
+	var diff *InstanceDiff
+	var provider ResourceProvider
+	var resourceConfig *ResourceConfig
+	var state *InstanceState
+
+	// Build the resource. If we aren't part of a multi-resource, then
+	// we still consider ourselves as count index zero.
+	index := n.Index
+	if index < 0 {
+		index = 0
+	}
+	resource := &Resource{
+		Name:       n.Resource.Name,
+		Type:       n.Resource.Type,
+		CountIndex: index,
+	}
+
+	seq := &EvalSequence{Nodes: make([]EvalNode, 0, 5)}
+
+	// Validate the data source. Data sources can't have provisioners,
+	// which is enforced when the configuration is loaded.
+	seq.Nodes = append(seq.Nodes, &EvalOpFilter{
+		Ops: []walkOperation{walkValidate},
+		Node: &EvalSequence{
+			Nodes: []EvalNode{
+				&EvalGetProvider{
+					Name:   n.ProvidedBy()[0],
+					Output: &provider,
+				},
+				&EvalInterpolate{
+					Config:   n.Resource.RawConfig.Copy(),
+					Resource: resource,
+					Output:   &resourceConfig,
+				},
+				&EvalValidateResource{
+					Provider:     &provider,
+					Config:       &resourceConfig,
+					ResourceName: n.Resource.Name,
+					ResourceType: n.Resource.Type,
+					IsDataSource: true,
+				},
+			},
+		},
+	})
+
+	// Build instance info
+	info := n.instanceInfo()
+	seq.Nodes = append(seq.Nodes, &EvalInstanceInfo{Info: info})
+
+	// Refresh the data source. If the configuration can't be fully
+	// resolved yet then we skip it here and read it during apply
+	// instead, once the values it depends on are known.
+	seq.Nodes = append(seq.Nodes, &EvalOpFilter{
+		Ops: []walkOperation{walkRefresh},
+		Node: &EvalSequence{
+			Nodes: []EvalNode{
+				&EvalInterpolate{
+					Config:   n.Resource.RawConfig.Copy(),
+					Resource: resource,
+					Output:   &resourceConfig,
+				},
+				&EvalIf{
+					If: func(ctx EvalContext) (bool, error) {
+						if len(resourceConfig.ComputedKeys) > 0 {
+							return true, EvalEarlyExitError{}
+						}
+
+						return true, nil
+					},
+					Then: EvalNoop{},
+				},
+				&EvalGetProvider{
+					Name:   n.ProvidedBy()[0],
+					Output: &provider,
+				},
+				&EvalReadDataDiff{
+					Info:     info,
+					Config:   &resourceConfig,
+					Provider: &provider,
+					Output:   &diff,
+				},
+				&EvalReadDataApply{
+					Info:     info,
+					Diff:     &diff,
+					Provider: &provider,
+					Output:   &state,
+				},
+				&EvalWriteState{
+					Name:         n.stateId(),
+					ResourceType: n.Resource.Type,
+					Provider:     n.Resource.Provider,
+					Dependencies: n.StateDependencies(),
+					State:        &state,
+				},
+				&EvalUpdateStateHook{},
+			},
+		},
+	})
+
+	// Diff the data source. If it was already read during refresh
+	// then there is nothing to do. Otherwise we produce a diff that
+	// will cause it to be read during apply, and put the computed
+	// attributes in the state so that dependents can plan.
+	seq.Nodes = append(seq.Nodes, &EvalOpFilter{
+		Ops: []walkOperation{walkPlan},
+		Node: &EvalSequence{
+			Nodes: []EvalNode{
+				&EvalInterpolate{
+					Config:   n.Resource.RawConfig.Copy(),
+					Resource: resource,
+					Output:   &resourceConfig,
+				},
+				&EvalReadState{
+					Name:   n.stateId(),
+					Output: &state,
+				},
+				&EvalIf{
+					If: func(ctx EvalContext) (bool, error) {
+						if len(resourceConfig.ComputedKeys) == 0 && state != nil {
+							return true, EvalEarlyExitError{}
+						}
+
+						return true, nil
+					},
+					Then: EvalNoop{},
+				},
+				&EvalGetProvider{
+					Name:   n.ProvidedBy()[0],
+					Output: &provider,
+				},
+				&EvalReadDataDiff{
+					Info:        info,
+					Config:      &resourceConfig,
+					Provider:    &provider,
+					Output:      &diff,
+					OutputState: &state,
+				},
+				&EvalWriteState{
+					Name:         n.stateId(),
+					ResourceType: n.Resource.Type,
+					Provider:     n.Resource.Provider,
+					Dependencies: n.StateDependencies(),
+					State:        &state,
+				},
+				&EvalWriteDiff{
+					Name: n.stateId(),
+					Diff: &diff,
+				},
+			},
+		},
+	})
+
+	// Data sources are never really destroyed, but we produce a destroy
+	// diff so that they are removed from the state during apply.
+	seq.Nodes = append(seq.Nodes, &EvalOpFilter{
+		Ops: []walkOperation{walkPlanDestroy},
+		Node: &EvalSequence{
+			Nodes: []EvalNode{
+				&EvalReadState{
+					Name:   n.stateId(),
+					Output: &state,
+				},
+				&EvalDiffDestroy{
+					Info:   info,
+					State:  &state,
+					Output: &diff,
+				},
+				&EvalWriteDiff{
+					Name: n.stateId(),
+					Diff: &diff,
+				},
+			},
+		},
+	})
+
+	// Apply. Data sources that were read during refresh have no diff,
+	// so this only does something for those whose configuration wasn't
+	// known until now, or those that are being removed.
+	seq.Nodes = append(seq.Nodes, &EvalOpFilter{
+		Ops: []walkOperation{walkApply, walkDestroy},
+		Node: &EvalSequence{
+			Nodes: []EvalNode{
+				&EvalReadDiff{
+					Name: n.stateId(),
+					Diff: &diff,
+				},
+				&EvalIf{
+					If: func(ctx EvalContext) (bool, error) {
+						if diff == nil {
+							return true, EvalEarlyExitError{}
+						}
+
+						return diff.Destroy, nil
+					},
+					Then: EvalNoop{},
+					Else: &EvalSequence{
+						Nodes: []EvalNode{
+							&EvalInterpolate{
+								Config:   n.Resource.RawConfig.Copy(),
+								Resource: resource,
+								Output:   &resourceConfig,
+							},
+							&EvalGetProvider{
+								Name:   n.ProvidedBy()[0],
+								Output: &provider,
+							},
+
+							// The configuration is complete now, so
+							// we re-diff to get the final arguments.
+							&EvalReadDataDiff{
+								Info:     info,
+								Config:   &resourceConfig,
+								Provider: &provider,
+								Output:   &diff,
+							},
+						},
+					},
+				},
+				&EvalGetProvider{
+					Name:   n.ProvidedBy()[0],
+					Output: &provider,
+				},
+				&EvalReadDataApply{
+					Info:     info,
+					Diff:     &diff,
+					Provider: &provider,
+					Output:   &state,
+				},
+				&EvalWriteState{
+					Name:         n.stateId(),
+					ResourceType: n.Resource.Type,
+					Provider:     n.Resource.Provider,
+					Dependencies: n.StateDependencies(),
+					State:        &state,
+				},
+
+				// Clear the diff now that we've applied it, so
+				// later nodes won't see a diff that's now a no-op.
+				&EvalWriteDiff{
+					Name: n.stateId(),
+					Diff: nil,
+				},
+
+				&EvalUpdateStateHook{},
+			},
+		},
+	})
+
+	return seq
+}
+
 // instanceInfo is used for EvalTree.
 func (n *graphNodeExpandedResource) instanceInfo() *InstanceInfo {
 	return &InstanceInfo{Id: n.stateId(), Type: n.Resource.Type}